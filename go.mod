@@ -5,5 +5,6 @@ require (
 	github.com/hashicorp/go-cleanhttp v0.5.0
 	github.com/hashicorp/go-oracle-terraform v0.16.3
 	github.com/hashicorp/terraform v0.12.8
+	github.com/mitchellh/mapstructure v1.1.2
 	gopkg.in/jarcoal/httpmock.v1 v1.0.0-20190204112747-618f46f3f0c8 // indirect
 )
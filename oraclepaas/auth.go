@@ -0,0 +1,152 @@
+package oraclepaas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tokens are refreshed this long before they expire, so that a request isn't sent with a token that
+// expires in flight
+const oauthTokenExpiryDelta = 60 * time.Second
+
+// authenticator authorizes the requests sent to the OPAAS APIs. The SDK clients always authenticate
+// with the username and password, so an authenticator replaces the Authorization header through the
+// HTTP client in opc.Config.
+type authenticator interface {
+	// authorize sets the Authorization header of the request
+	authorize(req *http.Request) error
+	// invalidate discards any cached credentials after the API rejected them
+	invalidate()
+}
+
+// authTransport is a http.RoundTripper that authorizes each request with the authenticator
+type authTransport struct {
+	base          http.RoundTripper
+	authenticator authenticator
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so authorize a copy of it
+	authReq := req.Clone(req.Context())
+	if err := t.authenticator.authorize(authReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(authReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// The token may have been revoked, fetch a new token for the retry
+	if resp.StatusCode == http.StatusUnauthorized {
+		t.authenticator.invalidate()
+	}
+
+	return resp, nil
+}
+
+// oauthAuthenticator authorizes requests with a bearer token obtained from an OAuth2 token endpoint,
+// such as Oracle Identity Cloud Service (IDCS), using the client credentials grant. The token is
+// cached and refreshed before it expires.
+type oauthAuthenticator struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+	scope        string
+	httpClient   *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// oauthTokenResponse is the response of the OAuth2 token endpoint
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (a *oauthAuthenticator) authorize(req *http.Request) error {
+	token, err := a.getToken()
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+func (a *oauthAuthenticator) invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.token = ""
+}
+
+// getToken returns the cached token, fetching a new token if it's missing or about to expire
+func (a *oauthAuthenticator) getToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Add(oauthTokenExpiryDelta).Before(a.expiry) {
+		return a.token, nil
+	}
+
+	token, err := a.fetchToken()
+	if err != nil {
+		return "", err
+	}
+
+	a.token = token.AccessToken
+	a.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return a.token, nil
+}
+
+func (a *oauthAuthenticator) fetchToken() (*oauthTokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if a.scope != "" {
+		form.Set("scope", a.scope)
+	}
+
+	req, err := http.NewRequest("POST", a.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(a.clientID), url.QueryEscape(a.clientSecret))
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error requesting OAuth token from %s: %+v", a.tokenURL, err)
+	}
+	defer resp.Body.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error requesting OAuth token from %s: %d %s", a.tokenURL, resp.StatusCode, buf.String())
+	}
+
+	var token oauthTokenResponse
+	if err := json.Unmarshal(buf.Bytes(), &token); err != nil {
+		return nil, fmt.Errorf("Error decoding OAuth token response: %+v", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("OAuth token response from %s does not contain an access token", a.tokenURL)
+	}
+
+	return &token, nil
+}
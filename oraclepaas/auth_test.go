@@ -0,0 +1,92 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOAuthAuthenticator_CachesToken(t *testing.T) {
+	requests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`, requests)
+	}))
+	defer tokenServer.Close()
+
+	var authorization string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer apiServer.Close()
+
+	authenticator := &oauthAuthenticator{
+		clientID:     "client",
+		clientSecret: "secret",
+		tokenURL:     tokenServer.URL,
+		httpClient:   http.DefaultClient,
+	}
+	client := &http.Client{
+		Transport: &authTransport{
+			base:          http.DefaultTransport,
+			authenticator: authenticator,
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", apiServer.URL, nil)
+		req.SetBasicAuth("user", "password")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+		resp.Body.Close()
+
+		if authorization != "Bearer token-1" {
+			t.Fatalf("Expected the request to be authorized with the cached token, got %q", authorization)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("Expected 1 token request, got %d", requests)
+	}
+
+	// An expired token is refreshed
+	authenticator.expiry = authenticator.expiry.Add(-time.Hour)
+	if _, err := authenticator.getToken(); err != nil {
+		t.Fatalf("Error refreshing token: %+v", err)
+	}
+	if authenticator.token != "token-2" {
+		t.Fatalf("Expected the token to be refreshed, got %q", authenticator.token)
+	}
+}
+
+func TestOAuthAuthenticator_InvalidClient(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error": "invalid_client"}`)
+	}))
+	defer tokenServer.Close()
+
+	authenticator := &oauthAuthenticator{
+		clientID:     "client",
+		clientSecret: "wrong",
+		tokenURL:     tokenServer.URL,
+		httpClient:   http.DefaultClient,
+	}
+
+	if _, err := authenticator.getToken(); err == nil {
+		t.Fatal("Expected an error for an invalid client")
+	}
+}
@@ -2,10 +2,14 @@ package oraclepaas
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-oracle-terraform/application"
@@ -21,8 +25,22 @@ type Config struct {
 	User                string
 	Password            string
 	IdentityDomain      string
+	OAuthClientID       string
+	OAuthClientSecret   string
+	OAuthTokenURL       string
+	OAuthScope          string
 	MaxRetries          int
+	RetryOn             []string
+	RetryMaxElapsedTime time.Duration
 	Insecure            bool
+	ProxyURL            string
+	CACertFile          string
+	CACertPEM           string
+	ClientCertFile      string
+	ClientKeyFile       string
+	Region              string
+	DataCenter          string
+	Infrastructure      string
 	DatabaseEndpoint    string
 	JavaEndpoint        string
 	ApplicationEndpoint string
@@ -34,17 +52,62 @@ type OPAASClient struct {
 	javaClient        *java.Client
 	applicationClient *application.Client
 	mysqlClient       *mysql.MySQLClient
+
+	databasePSMClient    *psmClient
+	javaPSMClient        *psmClient
+	mysqlPSMClient       *psmClient
+	applicationPSMClient *psmClient
 }
 
 func (c *Config) Client() (*OPAASClient, error) {
+	if c.IdentityDomain == "" {
+		return nil, fmt.Errorf("identity_domain must be set")
+	}
+	if c.OAuthClientID == "" && (c.User == "" || c.Password == "") {
+		return nil, fmt.Errorf("Either user and password, or oauth_client_id, oauth_client_secret and oauth_token_url must be set")
+	}
+	if c.OAuthClientID != "" && (c.OAuthClientSecret == "" || c.OAuthTokenURL == "") {
+		return nil, fmt.Errorf("oauth_client_secret and oauth_token_url must be set when using oauth_client_id")
+	}
+
+	// Endpoints derived from the region are only used for the endpoints that aren't set explicitly
+	region := c.Region
+	if region == "" && c.DataCenter != "" {
+		var err error
+		if region, err = regionForDataCenter(c.DataCenter); err != nil {
+			return nil, err
+		}
+	}
+	if region != "" {
+		endpoints, err := regionEndpoints(region, c.Infrastructure)
+		if err != nil {
+			return nil, err
+		}
+		if c.DatabaseEndpoint == "" {
+			c.DatabaseEndpoint = endpoints.Database
+		}
+		if c.JavaEndpoint == "" {
+			c.JavaEndpoint = endpoints.Java
+		}
+		if c.ApplicationEndpoint == "" {
+			c.ApplicationEndpoint = endpoints.Application
+		}
+		if c.MySQLEndpoint == "" {
+			c.MySQLEndpoint = endpoints.MySQL
+		}
+	}
 
 	userAgentString := fmt.Sprintf("HashiCorp-Terraform-v%s", terraform.VersionString())
 
+	// Requests are retried by the transport according to the retry policy, so the SDK clients only
+	// make a single attempt
+	sdkMaxRetries := 1
+
 	config := opc.Config{
 		IdentityDomain: &c.IdentityDomain,
 		Username:       &c.User,
 		Password:       &c.Password,
-		MaxRetries:     &c.MaxRetries,
+		MaxRetries:     &sdkMaxRetries,
 		UserAgent:      &userAgentString,
 	}
 
@@ -53,14 +116,38 @@ func (c *Config) Client() (*OPAASClient, error) {
 		config.Logger = oraclepaasLogger{}
 	}
 
-	// Setup HTTP Client based on insecure
+	// Setup HTTP Client based on the proxy and TLS settings
 	httpClient := cleanhttp.DefaultClient()
-	if c.Insecure {
-		transport := cleanhttp.DefaultTransport()
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true,
+	transport, err := c.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = transport
+
+	// The SDK clients always use basic authentication, so OAuth tokens are set by the transport
+	if c.OAuthClientID != "" {
+		if _, err := url.ParseRequestURI(c.OAuthTokenURL); err != nil {
+			return nil, fmt.Errorf("Invalid OAuth token URL: %+v", err)
+		}
+		httpClient.Transport = &authTransport{
+			base: httpClient.Transport,
+			authenticator: &oauthAuthenticator{
+				clientID:     c.OAuthClientID,
+				clientSecret: c.OAuthClientSecret,
+				tokenURL:     c.OAuthTokenURL,
+				scope:        c.OAuthScope,
+				httpClient: &http.Client{
+					Transport: httpClient.Transport,
+					Timeout:   httpClient.Timeout,
+				},
+			},
 		}
-		httpClient.Transport = transport
+	}
+
+	// Retries wrap the authentication, so each attempt is authorized with a valid token
+	httpClient.Transport = &retryTransport{
+		base:   httpClient.Transport,
+		policy: newRetryPolicy(c.MaxRetries, c.RetryOn, c.RetryMaxElapsedTime),
 	}
 
 	config.HTTPClient = httpClient
@@ -78,6 +165,11 @@ func (c *Config) Client() (*OPAASClient, error) {
 			return nil, err
 		}
 		oraclepaasClient.databaseClient = databaseClient
+		databasePSMClient, err := newPSMClient(&config, psmServiceTypeDatabase)
+		if err != nil {
+			return nil, err
+		}
+		oraclepaasClient.databasePSMClient = databasePSMClient
 	}
 
 	if c.JavaEndpoint != "" {
@@ -91,6 +183,11 @@ func (c *Config) Client() (*OPAASClient, error) {
 			return nil, err
 		}
 		oraclepaasClient.javaClient = javaClient
+		javaPSMClient, err := newPSMClient(&config, psmServiceTypeJava)
+		if err != nil {
+			return nil, err
+		}
+		oraclepaasClient.javaPSMClient = javaPSMClient
 	}
 
 	if c.ApplicationEndpoint != "" {
@@ -104,6 +201,11 @@ func (c *Config) Client() (*OPAASClient, error) {
 			return nil, err
 		}
 		oraclepaasClient.applicationClient = applicationClient
+		applicationPSMClient, err := newPSMClient(&config, psmServiceTypeApplication)
+		if err != nil {
+			return nil, err
+		}
+		oraclepaasClient.applicationPSMClient = applicationPSMClient
 	}
 
 	if c.MySQLEndpoint != "" {
@@ -117,11 +219,71 @@ func (c *Config) Client() (*OPAASClient, error) {
 			return nil, err
 		}
 		oraclepaasClient.mysqlClient = mysqlClient
+		mysqlPSMClient, err := newPSMClient(&config, psmServiceTypeMySQL)
+		if err != nil {
+			return nil, err
+		}
+		oraclepaasClient.mysqlPSMClient = mysqlPSMClient
 	}
 
 	return oraclepaasClient, nil
 }
 
+// httpTransport builds the transport shared by all the clients, applying the proxy and TLS settings
+func (c *Config) httpTransport() (*http.Transport, error) {
+	transport := cleanhttp.DefaultTransport()
+
+	// Without a proxy URL, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proxy URL: %+v", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	// Additional CA certificates are trusted alongside the system certificates, e.g. for a TLS
+	// intercepting proxy
+	if c.CACertFile != "" || c.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if c.CACertFile != "" {
+			pem, err := ioutil.ReadFile(c.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("Error reading CA certificate file %q: %+v", c.CACertFile, err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("No certificates found in CA certificate file %q", c.CACertFile)
+			}
+		}
+		if c.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+			return nil, fmt.Errorf("No certificates found in ca_cert_pem")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertFile != "" || c.ClientKeyFile != "" {
+		if c.ClientCertFile == "" || c.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client_cert_file and client_key_file must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client certificate: %+v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
 type oraclepaasLogger struct{}
 
 func (l oraclepaasLogger) Log(args ...interface{}) {
@@ -0,0 +1,137 @@
+package oraclepaas
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Default location and profile of the provider config file
+const (
+	defaultConfigFile    = "~/.oraclepaas/config"
+	defaultConfigProfile = "DEFAULT"
+)
+
+// configFileKeys are the provider arguments that can be set by a config file profile
+var configFileKeys = []string{
+	"user",
+	"password",
+	"identity_domain",
+	"oauth_client_id",
+	"oauth_client_secret",
+	"oauth_token_url",
+	"oauth_scope",
+	"database_endpoint",
+	"java_endpoint",
+	"application_endpoint",
+	"mysql_endpoint",
+	"region",
+	"data_center",
+	"infrastructure",
+	"proxy_url",
+	"ca_cert_file",
+	"ca_cert_pem",
+	"client_cert_file",
+	"client_key_file",
+}
+
+// readConfigFileProfile reads the settings of the given profile from the config file. The file is
+// either a JSON object of profiles, or an INI file with a section per profile, e.g.
+//
+//	[DEFAULT]
+//	user = user@example.com
+//	identity_domain = idcs-5bb188b5460045f3943c57b783db7ffa
+func readConfigFileProfile(path, profile string) (map[string]string, error) {
+	if path == "" {
+		path = defaultConfigFile
+	}
+	if profile == "" {
+		profile = defaultConfigProfile
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding config file path %q: %+v", path, err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %q: %+v", path, err)
+	}
+
+	var profiles map[string]map[string]string
+	if strings.HasPrefix(strings.TrimSpace(string(contents)), "{") {
+		if err := json.Unmarshal(contents, &profiles); err != nil {
+			return nil, fmt.Errorf("Error parsing config file %q: %+v", path, err)
+		}
+	} else {
+		if profiles, err = parseINIProfiles(contents); err != nil {
+			return nil, fmt.Errorf("Error parsing config file %q: %+v", path, err)
+		}
+	}
+
+	settings, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q not found in config file %q", profile, path)
+	}
+
+	for key := range settings {
+		if !contains(key, configFileKeys) {
+			return nil, fmt.Errorf("Unsupported setting %q in profile %q of config file %q, expected one of %s", key, profile, path, strings.Join(configFileKeys, ", "))
+		}
+	}
+
+	return settings, nil
+}
+
+// parseINIProfiles parses the sections of an INI file into profiles. Lines starting with `#` or `;`
+// are comments, and values may be quoted.
+func parseINIProfiles(contents []byte) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+
+	var section map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = make(map[string]string)
+			}
+			section = profiles[name]
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected a [profile] section or a key = value setting", lineNumber)
+		}
+		if section == nil {
+			return nil, fmt.Errorf("line %d: setting is not in a [profile] section", lineNumber)
+		}
+
+		value := strings.TrimSpace(parts[1])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		section[strings.TrimSpace(parts[0])] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
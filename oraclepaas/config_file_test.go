@@ -0,0 +1,61 @@
+package oraclepaas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadConfigFileProfile(t *testing.T) {
+	cases := []struct {
+		path     string
+		profile  string
+		expected map[string]string
+	}{
+		{
+			path: "testdata/config",
+			expected: map[string]string{
+				"user":            "user@example.com",
+				"password":        "Pa55_Word",
+				"identity_domain": "idcs-default",
+			},
+		},
+		{
+			path:    "testdata/config",
+			profile: "staging",
+			expected: map[string]string{
+				"identity_domain":   "idcs-staging",
+				"database_endpoint": "https://dbaas.oraclecloud.com/",
+			},
+		},
+		{
+			path:    "testdata/config.json",
+			profile: "staging",
+			expected: map[string]string{
+				"identity_domain": "idcs-staging",
+				"java_endpoint":   "https://jaas.oraclecloud.com/",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		settings, err := readConfigFileProfile(tc.path, tc.profile)
+		if err != nil {
+			t.Fatalf("Error reading profile %q of %q: %+v", tc.profile, tc.path, err)
+		}
+		if !reflect.DeepEqual(settings, tc.expected) {
+			t.Fatalf("Expected %+v for profile %q of %q, got %+v", tc.expected, tc.profile, tc.path, settings)
+		}
+	}
+}
+
+func TestReadConfigFileProfile_Invalid(t *testing.T) {
+	if _, err := readConfigFileProfile("testdata/config", "production"); err == nil {
+		t.Fatal("Expected an error for a missing profile")
+	}
+	if _, err := readConfigFileProfile("testdata/missing", ""); err == nil {
+		t.Fatal("Expected an error for a missing config file")
+	}
+	if _, err := parseINIProfiles([]byte("user = user@example.com")); err == nil {
+		t.Fatal("Expected an error for a setting outside of a profile")
+	}
+}
@@ -0,0 +1,55 @@
+package oraclepaas
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfigHTTPTransport_CACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The server's self-signed certificate isn't trusted by default
+	transport, err := (&Config{}).httpTransport()
+	if err != nil {
+		t.Fatalf("Error building transport: %+v", err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		t.Fatal("Expected an error for an untrusted certificate")
+	}
+
+	config := &Config{
+		CACertPEM: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw,
+		})),
+	}
+	transport, err = config.httpTransport()
+	if err != nil {
+		t.Fatalf("Error building transport: %+v", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Error sending request with the CA certificate: %+v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestConfigHTTPTransport_Invalid(t *testing.T) {
+	cases := map[string]*Config{
+		"invalid CA certificate":  {CACertPEM: "not a certificate"},
+		"missing CA certificate":  {CACertFile: "testdata/missing.pem"},
+		"client certificate only": {ClientCertFile: "testdata/client.pem"},
+		"invalid proxy URL":       {ProxyURL: "://proxy"},
+	}
+
+	for name, config := range cases {
+		if _, err := config.httpTransport(); err == nil {
+			t.Fatalf("Expected an error for %s", name)
+		}
+	}
+}
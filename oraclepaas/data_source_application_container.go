@@ -0,0 +1,217 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/application"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASApplicationContainer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASApplicationContainerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_deployment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceApplicationContainerDeploymentSchema(),
+			},
+			"memory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"running_deployment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceApplicationContainerDeploymentSchema(),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApplicationContainerDeploymentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASApplicationContainerRead(d *schema.ResourceData, meta interface{}) error {
+	aClient, err := getApplicationClient(meta)
+	if err != nil {
+		return err
+	}
+	client := aClient.ContainerClient()
+
+	// Get required attributes
+	name := d.Get("name").(string)
+
+	input := application.GetApplicationContainerInput{
+		Name: name,
+	}
+
+	result, err := client.GetApplicationContainer(&input)
+	if err != nil {
+		return err
+	}
+
+	// Not found, don't error
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Populate schema attributes
+	d.SetId(result.Name)
+	d.Set("name", result.Name)
+	d.Set("app_id", result.AppID)
+	d.Set("app_url", result.AppURL)
+	d.Set("created_time", result.CreatedTime)
+	d.Set("last_modified_time", result.LastModifiedTime)
+	d.Set("status", result.Status)
+	d.Set("subscription_type", result.SubscriptionType)
+	d.Set("web_url", result.WebURL)
+
+	// Every instance of an application container is given the same amount of memory
+	d.Set("instance_count", len(result.Instances))
+	if len(result.Instances) > 0 {
+		d.Set("memory", result.Instances[0].Memory)
+	}
+	if err := d.Set("instances", flattenApplicationContainerInstances(result.Instances)); err != nil {
+		return fmt.Errorf("Error setting Application Container Instances: %+v", err)
+	}
+
+	if err := d.Set("latest_deployment", flattenApplicationContainerDeployment(result.LatestDeployment)); err != nil {
+		return fmt.Errorf("Error setting Application Container Latest Deployment: %+v", err)
+	}
+	if err := d.Set("running_deployment", flattenApplicationContainerDeployment(result.RunningDeployment)); err != nil {
+		return fmt.Errorf("Error setting Application Container Running Deployment: %+v", err)
+	}
+
+	// The runtime and tags are only returned when listing the application containers
+	summaryClient, err := getPSMClient(meta, psmServiceTypeApplication)
+	if err != nil {
+		return err
+	}
+	summary, err := summaryClient.getServiceInstanceSummary(result.Name)
+	if err != nil {
+		return fmt.Errorf("Error reading runtime and tags of Application Container %q: %+v", result.Name, err)
+	}
+	if summary != nil {
+		d.Set("runtime", summary.Runtime)
+		if err := d.Set("tags", summary.Tags); err != nil {
+			return fmt.Errorf("Error setting Application Container Tags: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenApplicationContainerInstances(instances []application.Instance) []interface{} {
+	result := make([]interface{}, 0, len(instances))
+
+	for _, instance := range instances {
+		result = append(result, map[string]interface{}{
+			"name":         instance.Name,
+			"instance_url": instance.InstanceURL,
+			"memory":       instance.Memory,
+			"status":       instance.Status,
+		})
+	}
+
+	return result
+}
+
+func flattenApplicationContainerDeployment(deployment application.Deployment) []interface{} {
+	if deployment.DeploymentID == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"deployment_id":     deployment.DeploymentID,
+		"deployment_status": deployment.DeploymentStatus,
+		"deployment_url":    deployment.DeploymentURL,
+	}}
+}
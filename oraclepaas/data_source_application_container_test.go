@@ -0,0 +1,41 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceApplicationContainer_Basic(t *testing.T) {
+	ri := acctest.RandIntRange(1, 10000)
+	config := testAccDataSourceApplicationContainerBasic(ri)
+	resourceName := "data.oraclepaas_application_container.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationContainerExists,
+					resource.TestCheckResourceAttrPair(
+						resourceName, "app_url", "oraclepaas_application_container.test", "app_url"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "web_url", "oraclepaas_application_container.test", "web_url"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "instance_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplicationContainerBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_application_container" "test" {
+    name = "${oraclepaas_application_container.test.name}"
+}`, testAccApplicationContainerBasic(rInt))
+}
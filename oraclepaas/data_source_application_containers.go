@@ -0,0 +1,85 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/application"
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASApplicationContainers() *schema.Resource {
+	s := serviceInstanceFilterSchema()
+	s["application_containers"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"app_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"web_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"subscription_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceOraclePAASApplicationContainersRead,
+		Schema: s,
+	}
+}
+
+func dataSourceOraclePAASApplicationContainersRead(d *schema.ResourceData, meta interface{}) error {
+	aClient, err := getApplicationClient(meta)
+	if err != nil {
+		return err
+	}
+	client := aClient.ContainerClient()
+
+	return readServiceInstanceList(d, meta, psmServiceTypeApplication, "application_containers", func(name string) (map[string]interface{}, error) {
+		result, err := client.GetApplicationContainer(&application.GetApplicationContainerInput{Name: name})
+		if err != nil {
+			if opcClient.WasNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error reading application container %s: %+v", name, err)
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		return map[string]interface{}{
+			"name":              result.Name,
+			"status":            result.Status,
+			"app_url":           result.AppURL,
+			"web_url":           result.WebURL,
+			"subscription_type": string(result.SubscriptionType),
+			"instance_count":    len(result.Instances),
+		}, nil
+	})
+}
@@ -0,0 +1,43 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceApplicationContainers_Basic(t *testing.T) {
+	ri := acctest.RandIntRange(1, 10000)
+	config := testAccDataSourceApplicationContainersBasic(ri)
+	resourceName := "data.oraclepaas_application_containers.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationContainerExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "names.0", "oraclepaas_application_container.test", "name"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "application_containers.0.name", "oraclepaas_application_container.test", "name"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "application_containers.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplicationContainersBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_application_containers" "test" {
+    name_regex = "^${oraclepaas_application_container.test.name}$"
+}`, testAccApplicationContainerBasic(rInt))
+}
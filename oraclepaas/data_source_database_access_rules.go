@@ -0,0 +1,112 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceOraclePAASDatabaseAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASDatabaseAccessRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(database.AccessRuleTypeDefault),
+					string(database.AccessRuleTypeSystem),
+					string(database.AccessRuleTypeUser),
+				}, false),
+			},
+			"access_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ports": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASDatabaseAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	// Get required attributes
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	var accessRules database.AccessRules
+	if err := client.getAccessRules(serviceInstanceID, &accessRules); err != nil {
+		return fmt.Errorf("Error reading Database Access Rules for %s: %+v", serviceInstanceID, err)
+	}
+	rules := accessRules.Rules
+
+	// Populate schema attributes
+	d.SetId(serviceInstanceID)
+	if err := d.Set("access_rules", flattenDatabaseAccessRules(rules, d.Get("rule_type").(string))); err != nil {
+		return fmt.Errorf("Error setting Database Access Rules: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDatabaseAccessRules(rules []database.AccessRuleInfo, ruleType string) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if ruleType != "" && string(rule.RuleType) != ruleType {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":        rule.Name,
+			"description": rule.Description,
+			"destination": string(rule.Destination),
+			"ports":       rule.Ports,
+			"rule_type":   string(rule.RuleType),
+			"source":      rule.Source,
+			"status":      string(rule.Status),
+		})
+	}
+
+	return result
+}
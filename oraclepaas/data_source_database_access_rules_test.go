@@ -0,0 +1,44 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceDatabaseAccessRules_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceDatabaseAccessRulesBasic(ri)
+	resourceName := "data.oraclepaas_database_access_rules.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseAccessRuleExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "access_rules.0.name", "oraclepaas_database_access_rule.test", "name"),
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.0.rule_type", "USER"),
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.0.status", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabaseAccessRulesBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_database_access_rules" "test" {
+    service_instance_id = "${oraclepaas_database_access_rule.test.service_instance_id}"
+    rule_type = "USER"
+}`, testAccDatabaseAccessRuleBasic(rInt))
+}
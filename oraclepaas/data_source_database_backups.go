@@ -0,0 +1,85 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASDatabaseBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASDatabaseBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"completion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keep_forever": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASDatabaseBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	result, err := client.getDatabaseBackups(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading backups of Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	d.SetId(serviceInstanceID)
+	if err := d.Set("backups", flattenDatabaseBackups(result)); err != nil {
+		return fmt.Errorf("Error setting Database Backups: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDatabaseBackups(backups []databaseBackup) []interface{} {
+	result := make([]interface{}, 0, len(backups))
+
+	for _, backup := range backups {
+		result = append(result, map[string]interface{}{
+			"backup_id":       backup.DBTag,
+			"completion_date": backup.BackupCompleteDate,
+			"keep_forever":    backup.KeepForever,
+			"status":          backup.Status,
+			"type":            backup.Type,
+		})
+	}
+
+	return result
+}
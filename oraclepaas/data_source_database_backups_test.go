@@ -0,0 +1,39 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceDatabaseBackups_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceDatabaseBackupsBasic(ri)
+	resourceName := "data.oraclepaas_database_backups.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseBackupExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "backups.0.backup_id", "oraclepaas_database_backup.test", "backup_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabaseBackupsBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_database_backups" "test" {
+    service_instance_id = "${oraclepaas_database_backup.test.service_instance_id}"
+}`, testAccDatabaseBackupBasic(rInt))
+}
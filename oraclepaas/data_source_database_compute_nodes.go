@@ -0,0 +1,169 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASDatabaseComputeNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASDatabaseComputeNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connect_descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connect_descriptor_with_public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initial_primary": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"listener_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_allocated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_cores": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pluggable_database_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reserved_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shape": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_allocated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vm_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASDatabaseComputeNodesRead(d *schema.ResourceData, meta interface{}) error {
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.ComputeNodes()
+
+	// Get required attributes
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	input := database.GetComputeNodesInput{
+		ServiceInstanceID: serviceInstanceID,
+	}
+
+	result, err := client.GetComputeNodes(&input)
+	if err != nil {
+		return err
+	}
+
+	// Not found, don't error
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Populate schema attributes
+	d.SetId(serviceInstanceID)
+	if err := d.Set("compute_nodes", flattenDatabaseComputeNodes(result.Nodes)); err != nil {
+		return fmt.Errorf("Error setting Database Compute Nodes: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDatabaseComputeNodes(nodes []database.ComputeNodeInfo) []interface{} {
+	result := make([]interface{}, 0, len(nodes))
+
+	for _, node := range nodes {
+		result = append(result, map[string]interface{}{
+			"availability_domain":               node.AvailabilityDomain,
+			"connect_descriptor":                node.ConnectDescriptor,
+			"connect_descriptor_with_public_ip": node.ConnectDescriptorWithPublicIP,
+			"created_by":                        node.CreatedBy,
+			"creation_job_id":                   node.CreationJobID,
+			"creation_time":                     node.CreationTime,
+			"hostname":                          node.Hostname,
+			"initial_primary":                   node.InitialPrimary,
+			"listener_port":                     node.ListenerPort,
+			"memory_allocated":                  node.MemoryAllocated,
+			"number_of_cores":                   node.NumberOfCores,
+			"pluggable_database_name":           node.PDBName,
+			"reserved_ip":                       node.ReservedIP,
+			"shape":                             node.Shape,
+			"sid":                               node.SID,
+			"status":                            node.Status,
+			"storage_allocated":                 node.StorageAllocated,
+			"subnet":                            node.Subnet,
+			"vm_type":                           node.VMType,
+		})
+	}
+
+	return result
+}
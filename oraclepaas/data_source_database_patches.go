@@ -0,0 +1,95 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASDatabasePatches() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASDatabasePatchesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"patches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"patch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entry_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"patch_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASDatabasePatchesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	result, err := client.getDatabaseAvailablePatches(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading available patches of Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	d.SetId(serviceInstanceID)
+	if err := d.Set("patches", flattenDatabasePatches(result)); err != nil {
+		return fmt.Errorf("Error setting Database Patches: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDatabasePatches(patches []databasePatch) []interface{} {
+	result := make([]interface{}, 0, len(patches))
+
+	for _, patch := range patches {
+		result = append(result, map[string]interface{}{
+			"patch_id":        patch.PatchID,
+			"category":        patch.PatchCategory,
+			"description":     patch.PatchDescription,
+			"entry_date":      patch.EntryDate,
+			"patch_number":    patch.PatchNumber,
+			"release_url":     patch.PatchReleaseURL,
+			"release_version": patch.ReleaseVersion,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceDatabasePatches_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceDatabasePatchesBasic(ri)
+	resourceName := "data.oraclepaas_database_patches.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseServiceInstanceExists,
+					resource.TestCheckResourceAttrPair(
+						resourceName, "service_instance_id", "oraclepaas_database_service_instance.test", "name"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "patches.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabasePatchesBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_database_patches" "test" {
+    service_instance_id = "${oraclepaas_database_service_instance.test.name}"
+}`, testAccDatabaseServiceInstanceBasic(rInt))
+}
@@ -0,0 +1,95 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASDatabaseServiceInstances() *schema.Resource {
+	s := serviceInstanceShapeFilterSchema()
+	s["service_instances"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"shape": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"edition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"connect_descriptor": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"creation_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceOraclePAASDatabaseServiceInstancesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceOraclePAASDatabaseServiceInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.ServiceInstanceClient()
+
+	return readServiceInstanceList(d, meta, psmServiceTypeDatabase, "service_instances", func(name string) (map[string]interface{}, error) {
+		result, err := client.GetServiceInstance(&database.GetServiceInstanceInput{Name: name})
+		if err != nil {
+			if opcClient.WasNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error reading database service instance %s: %+v", name, err)
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		return map[string]interface{}{
+			"name":               result.Name,
+			"description":        result.Description,
+			"status":             string(result.Status),
+			"shape":              result.Shape,
+			"version":            result.Version,
+			"edition":            result.Edition,
+			"connect_descriptor": result.ConnectDescriptor,
+			"creation_time":      result.CreationTime,
+		}, nil
+	})
+}
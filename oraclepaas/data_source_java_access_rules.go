@@ -0,0 +1,117 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/java"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceOraclePAASJavaAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASJavaAccessRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(java.AccessRuleTypeDefault),
+					string(java.AccessRuleTypeSystem),
+					string(java.AccessRuleTypeUser),
+				}, false),
+			},
+			"access_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ports": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASJavaAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	// Get required attributes
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	var accessRules java.AccessRules
+	if err := client.getAccessRules(serviceInstanceID, &accessRules); err != nil {
+		return fmt.Errorf("Error reading Java Access Rules for %s: %+v", serviceInstanceID, err)
+	}
+	rules := accessRules.Rules
+
+	// Populate schema attributes
+	d.SetId(serviceInstanceID)
+	if err := d.Set("access_rules", flattenJavaAccessRules(rules, d.Get("rule_type").(string))); err != nil {
+		return fmt.Errorf("Error setting Java Access Rules: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenJavaAccessRules(rules []java.AccessRuleInfo, ruleType string) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if ruleType != "" && string(rule.RuleType) != ruleType {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":        rule.Name,
+			"description": rule.Description,
+			"destination": string(rule.Destination),
+			"ports":       rule.Ports,
+			"protocol":    string(rule.Protocol),
+			"rule_type":   string(rule.RuleType),
+			"source":      rule.Source,
+			"status":      string(rule.Status),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,120 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceOraclePAASJavaPatches() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASJavaPatchesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"component": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"WLS",
+					"OTD",
+					"JDK",
+				}, false),
+			},
+			"patches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"patch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"components": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entry_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"patch_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requires_restart": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASJavaPatchesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	result, err := client.getJavaAvailablePatches(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading available patches of Java Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	d.SetId(serviceInstanceID)
+	if err := d.Set("patches", flattenJavaPatches(result, d.Get("component").(string))); err != nil {
+		return fmt.Errorf("Error setting Java Patches: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenJavaPatches(patches []javaPatch, component string) []interface{} {
+	result := make([]interface{}, 0, len(patches))
+
+	for _, patch := range patches {
+		if _, ok := patch.ComponentPatches[component]; component != "" && !ok {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"patch_id":         patch.PatchID,
+			"category":         patch.PatchCategory,
+			"components":       patch.components(),
+			"description":      patch.PatchDescription,
+			"entry_date":       patch.EntryDate,
+			"patch_number":     patch.PatchNumber,
+			"release_version":  patch.ReleaseVersion,
+			"requires_restart": patch.RequiresRestart,
+			"type":             patch.PatchType,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceJavaPatches_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceJavaPatchesBasic(ri)
+	resourceName := "data.oraclepaas_java_patches.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaServiceInstanceExists,
+					resource.TestCheckResourceAttrPair(
+						resourceName, "service_instance_id", "oraclepaas_java_service_instance.test", "name"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "patches.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJavaPatchesBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_java_patches" "test" {
+    service_instance_id = "${oraclepaas_java_service_instance.test.name}"
+    component = "WLS"
+}`, testAccJavaServiceInstanceBasic(rInt))
+}
@@ -0,0 +1,387 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-oracle-terraform/java"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASJavaServiceInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASJavaServiceInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_site_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"load_balancer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"console_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metering_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"oracle_traffic_director": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"listener": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"secured_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"privileged_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"privileged_secured_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"root_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weblogic_server": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cluster": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"server_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"servers_per_node": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"server": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"content_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"secured_content_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"role": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"managed_servers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"root_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"upper_stack_product_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASJavaServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	jClient, err := getJavaClient(meta)
+	if err != nil {
+		return err
+	}
+	client := jClient.ServiceInstanceClient()
+
+	// Get required attributes
+	name := d.Get("name").(string)
+
+	input := java.GetServiceInstanceInput{
+		Name: name,
+	}
+
+	result, err := client.GetServiceInstance(&input)
+	if err != nil {
+		return err
+	}
+
+	// Not found, don't error
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Populate schema attributes
+	d.SetId(result.ServiceName)
+	d.Set("name", result.ServiceName)
+	d.Set("compute_site_name", result.ComputeSiteName)
+	d.Set("creation_date", result.CreationDate)
+	d.Set("creator", result.Creator)
+	d.Set("description", result.ServiceDescription)
+	d.Set("domain_name", result.DomainName)
+	d.Set("edition", result.Edition)
+	d.Set("level", result.ServiceLevel)
+	d.Set("metering_frequency", result.MeteringFrequency)
+	d.Set("region", result.Region)
+	d.Set("release_version", result.ReleaseVersion)
+	d.Set("service_version", result.ServiceVersion)
+	d.Set("status", result.State)
+
+	if err := d.Set("load_balancer", flattenJavaLoadBalancerInfo(result.LoadBalancer)); err != nil {
+		return fmt.Errorf("Error setting Java Service Instance Load Balancer: %+v", err)
+	}
+	if err := d.Set("weblogic_server", flattenJavaWLSInfo(result.Components.WLS, result.WLSRoot)); err != nil {
+		return fmt.Errorf("Error setting Java Service Instance WebLogic Server: %+v", err)
+	}
+	if err := d.Set("oracle_traffic_director", flattenJavaOTDInfo(result.Components.OTD, result.OTDRoot)); err != nil {
+		return fmt.Errorf("Error setting Java Service Instance Oracle Traffic Director: %+v", err)
+	}
+
+	return nil
+}
+
+// Unlike flattenLoadBalancer, only the values returned from the api are flattened
+// as there's no configuration to read the rest from.
+func flattenJavaLoadBalancerInfo(loadBalancerInfo *java.LoadBalancerInfo) []interface{} {
+	if loadBalancerInfo == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"admin_url":   loadBalancerInfo.Public.LoadBalancerAdminURL,
+		"console_url": loadBalancerInfo.Public.LoadBalancerConsoleURL,
+		"url":         loadBalancerInfo.Public.URL,
+	}}
+}
+
+func flattenJavaWLSInfo(wls java.WLS, rootURL string) []interface{} {
+	if wls.AdminHostName == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"admin": []interface{}{map[string]interface{}{
+			"hostname": wls.AdminHostName,
+		}},
+		"cluster": flattenJavaClusterInfo(wls.Clusters),
+		"managed_servers": []interface{}{map[string]interface{}{
+			"server_count": len(wls.Hosts.UserHosts),
+		}},
+		"root_url":                 rootURL,
+		"upper_stack_product_name": wls.Attributes.UpperStackProductName.Value,
+		"version":                  wls.Version,
+	}}
+}
+
+func flattenJavaClusterInfo(clusters map[string]java.Clusters) []interface{} {
+	// Sort the clusters by name so the order is stable between reads
+	names := make([]string, 0, len(clusters))
+	for name := range clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]interface{}, 0, len(clusters))
+	for _, name := range names {
+		cluster := clusters[name]
+
+		serverNames := make([]string, 0, len(cluster.PaaSServers))
+		for serverName := range cluster.PaaSServers {
+			serverNames = append(serverNames, serverName)
+		}
+		sort.Strings(serverNames)
+
+		servers := make([]interface{}, 0, len(serverNames))
+		for _, serverName := range serverNames {
+			attrs := cluster.PaaSServers[serverName].Attributes
+			servers = append(servers, map[string]interface{}{
+				"name":                 serverName,
+				"content_port":         atoiOrZero(attrs.Port),
+				"secured_content_port": atoiOrZero(attrs.SSLPort),
+				"role":                 attrs.Role,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":             cluster.ClusterName,
+			"type":             cluster.Profile.ClusterType,
+			"server_count":     len(cluster.PaaSServers),
+			"servers_per_node": cluster.Profile.ServersPerNode,
+			"server":           servers,
+		})
+	}
+
+	return result
+}
+
+func flattenJavaOTDInfo(otd java.OTD, rootURL string) []interface{} {
+	if otd.AdminHostName == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"admin": []interface{}{map[string]interface{}{
+			"hostname": otd.AdminHostName,
+			"port":     atoiOrZero(otd.Attributes.AdminPort.Value),
+		}},
+		"listener": []interface{}{map[string]interface{}{
+			"port":                    atoiOrZero(otd.Attributes.ListenerPort.Value),
+			"secured_port":            atoiOrZero(otd.Attributes.SecuredListenerPort.Value),
+			"privileged_port":         atoiOrZero(otd.Attributes.PrivilgedListenerPort.Value),
+			"privileged_secured_port": atoiOrZero(otd.Attributes.PrivilegedSecureListenerPort.Value),
+		}},
+		"root_url": rootURL,
+		"version":  otd.Version,
+	}}
+}
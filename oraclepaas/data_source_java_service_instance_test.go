@@ -0,0 +1,47 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceJavaServiceInstance_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceJavaServiceInstanceBasic(ri)
+	resourceName := "data.oraclepaas_java_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "level", "PAAS"),
+					resource.TestCheckResourceAttr(
+						resourceName, "edition", "SUITE"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "weblogic_server.0.admin.0.hostname",
+						"oraclepaas_java_service_instance.test", "weblogic_server.0.admin.0.hostname"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "weblogic_server.0.root_url",
+						"oraclepaas_java_service_instance.test", "weblogic_server.0.root_url"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "weblogic_server.0.cluster.0.server.0.content_port"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJavaServiceInstanceBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_java_service_instance" "test" {
+    name = "${oraclepaas_java_service_instance.test.name}"
+}`, testAccJavaServiceInstanceBasic(rInt))
+}
@@ -0,0 +1,104 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"strings"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/java"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASJavaServiceInstances() *schema.Resource {
+	s := serviceInstanceShapeFilterSchema()
+	s["service_instances"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"shape": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"edition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"level": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"creation_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceOraclePAASJavaServiceInstancesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceOraclePAASJavaServiceInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	jClient, err := getJavaClient(meta)
+	if err != nil {
+		return err
+	}
+	client := jClient.ServiceInstanceClient()
+
+	return readServiceInstanceList(d, meta, psmServiceTypeJava, "service_instances", func(name string) (map[string]interface{}, error) {
+		result, err := client.GetServiceInstance(&java.GetServiceInstanceInput{Name: name})
+		if err != nil {
+			if opcClient.WasNotFoundError(err) || strings.Contains(err.Error(), "No such service") {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error reading Java service instance %s: %+v", name, err)
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		// The shape of the service instance is the shape of the WebLogic Server admin node
+		var shape string
+		for _, host := range result.Components.WLS.VMInstances {
+			if host.IsAdminNode {
+				shape = host.ShapeID
+			}
+		}
+
+		return map[string]interface{}{
+			"name":          result.ServiceName,
+			"description":   result.ServiceDescription,
+			"status":        string(result.State),
+			"shape":         shape,
+			"version":       result.ServiceVersion,
+			"edition":       string(result.Edition),
+			"level":         string(result.ServiceLevel),
+			"creation_date": result.CreationDate,
+		}, nil
+	})
+}
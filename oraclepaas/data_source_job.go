@@ -0,0 +1,121 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var jobServiceTypes = map[string]string{
+	"database": psmServiceTypeDatabase,
+	"java":     psmServiceTypeJava,
+	"mysql":    psmServiceTypeMySQL,
+}
+
+func dataSourceOraclePAASJob() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASJobRead,
+
+		Schema: map[string]*schema.Schema{
+			"job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"database",
+					"java",
+					"mysql",
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"initiated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"messages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activity_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASJobRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, jobServiceTypes[d.Get("service").(string)])
+	if err != nil {
+		return err
+	}
+
+	jobID := d.Get("job_id").(string)
+
+	result, err := client.getJob(jobID)
+	if err != nil {
+		return fmt.Errorf("Error reading job %s: %+v", jobID, err)
+	}
+
+	d.SetId(jobID)
+	d.Set("status", result.Status)
+	d.Set("operation_type", result.OperationType)
+	d.Set("summary_message", result.SummaryMessage)
+	d.Set("service_name", result.ServiceName)
+	d.Set("initiated_by", result.InitiatedBy)
+	d.Set("start_date", result.StartDate)
+	d.Set("end_date", result.EndDate)
+	if err := d.Set("messages", flattenJobMessages(result.Messages)); err != nil {
+		return fmt.Errorf("Error setting Job Messages: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenJobMessages(messages []psmJobMessage) []interface{} {
+	result := make([]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		result = append(result, map[string]interface{}{
+			"activity_date": message.ActivityDate,
+			"message":       message.Message,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,122 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceOraclePAASMySQLAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASMySQLAccessRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DEFAULT",
+					"SYSTEM",
+					"USER",
+				}, false),
+			},
+			"access_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ports": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASMySQLAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.AccessRules()
+
+	// Get required attributes
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	input := mysql.GetAccessRuleInput{
+		ServiceInstanceID: serviceInstanceID,
+	}
+
+	accessRules, err := client.GetAllAccessRules(&input)
+	if err != nil {
+		return fmt.Errorf("Error reading MySQL Access Rules for %s: %+v", serviceInstanceID, err)
+	}
+	rules := accessRules.AccessRules
+
+	// Populate schema attributes
+	d.SetId(serviceInstanceID)
+	if err := d.Set("access_rules", flattenMySQLAccessRules(rules, d.Get("rule_type").(string))); err != nil {
+		return fmt.Errorf("Error setting MySQL Access Rules: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMySQLAccessRules(rules []mysql.AccessRuleInfo, ruleType string) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if ruleType != "" && rule.RuleType != ruleType {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":        rule.RuleName,
+			"description": rule.Description,
+			"destination": rule.Destination,
+			"ports":       rule.Ports,
+			"protocol":    rule.Protocol,
+			"rule_type":   rule.RuleType,
+			"source":      rule.Source,
+			"status":      rule.Status,
+		})
+	}
+
+	return result
+}
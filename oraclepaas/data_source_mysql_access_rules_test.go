@@ -0,0 +1,44 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceMySQLAccessRules_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceMySQLAccessRulesBasic(ri)
+	resourceName := "data.oraclepaas_mysql_access_rules.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLAccessRuleExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "access_rules.0.name", "oraclepaas_mysql_access_rule.test", "name"),
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.0.rule_type", "USER"),
+					resource.TestCheckResourceAttr(
+						resourceName, "access_rules.0.status", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMySQLAccessRulesBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_mysql_access_rules" "test" {
+    service_instance_id = "${oraclepaas_mysql_access_rule.test.service_instance_id}"
+    rule_type = "USER"
+}`, testAccMySQLAccessRuleBasic(rInt))
+}
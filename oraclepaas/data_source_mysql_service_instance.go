@@ -0,0 +1,167 @@
+package oraclepaas
+
+import (
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASMySQLServiceInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOraclePAASMySQLServiceInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_site_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shape": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metering_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_destination": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_storage_container": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"mysql_charset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mysql_collation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mysql_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"em_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOraclePAASMySQLServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.ServiceInstanceClient()
+
+	// Get required attributes
+	name := d.Get("name").(string)
+
+	input := mysql.GetServiceInstanceInput{
+		Name: name,
+	}
+
+	result, err := client.GetServiceInstance(&input)
+	if err != nil {
+		return err
+	}
+
+	// Not found, don't error
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Populate schema attributes
+	d.SetId(result.ServiceName)
+	d.Set("name", result.ServiceName)
+	d.Set("compute_site_name", result.ComputeSiteName)
+	d.Set("creation_date", result.CreationDate)
+	d.Set("creator", result.Creator)
+	d.Set("description", result.ServiceDescription)
+	d.Set("status", result.Status)
+	d.Set("service_version", result.ServiceVersion)
+	d.Set("release_version", result.ReleaseVersion)
+	d.Set("metering_frequency", result.MeteringFrequency)
+	d.Set("backup_destination", result.BackupDestination)
+	d.Set("cloud_storage_container", result.CloudStorageContainer)
+	d.Set("db_storage", atoiOrZero(result.DataVolumeSize))
+	d.Set("mysql_port", atoiOrZero(result.MysqlPort))
+
+	instanceInfo := result.Components.Mysql
+	attributes := map[string]string{}
+	for key, attr := range instanceInfo.Attributes {
+		attributes[key] = attr.Value
+	}
+	d.Set("shape", attributes["shape"])
+	d.Set("db_name", attributes["MYSQL_DBNAME"])
+	d.Set("mysql_charset", attributes["MYSQL_CHARACTER_SET"])
+	d.Set("mysql_collation", attributes["MYSQL_COLLATION"])
+	d.Set("connect_string", attributes["CONNECT_STRING"])
+	// Only set when the service instance was created with an Enterprise Monitor
+	d.Set("em_url", attributes["EM_URL"])
+
+	// A MySQL service instance only has a single VM
+	for _, vmInstance := range instanceInfo.VMInstances {
+		d.Set("hostname", vmInstance.HostName)
+		d.Set("ip_address", vmInstance.IPAddress)
+		d.Set("public_ip_address", vmInstance.PublicIPAddress)
+	}
+
+	return nil
+}
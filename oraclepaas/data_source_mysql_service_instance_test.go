@@ -0,0 +1,48 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceMySQLServiceInstance_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceMySQLServiceInstanceBasic(ri)
+	resourceName := "data.oraclepaas_mysql_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "Test Service Instance with Storage"),
+					resource.TestCheckResourceAttr(
+						resourceName, "shape", "oc3"),
+					resource.TestCheckResourceAttr(
+						resourceName, "db_name", "demo_db"),
+					resource.TestCheckResourceAttr(
+						resourceName, "backup_destination", "BOTH"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "connect_string",
+						"oraclepaas_mysql_service_instance.test", "mysql_configuration.0.connect_string"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMySQLServiceInstanceBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_mysql_service_instance" "test" {
+    name = "${oraclepaas_mysql_service_instance.test.name}"
+}`, testAccMySQLServiceInstanceCloudStorage(rInt))
+}
@@ -0,0 +1,92 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOraclePAASMySQLServiceInstances() *schema.Resource {
+	s := serviceInstanceShapeFilterSchema()
+	s["service_instances"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"shape": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"connect_string": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"creation_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceOraclePAASMySQLServiceInstancesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceOraclePAASMySQLServiceInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.ServiceInstanceClient()
+
+	return readServiceInstanceList(d, meta, psmServiceTypeMySQL, "service_instances", func(name string) (map[string]interface{}, error) {
+		result, err := client.GetServiceInstance(&mysql.GetServiceInstanceInput{Name: name})
+		if err != nil {
+			if opcClient.WasNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error reading mysql service instance %s: %+v", name, err)
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		attributes := result.Components.Mysql.Attributes
+
+		return map[string]interface{}{
+			"name":           result.ServiceName,
+			"description":    result.ServiceDescription,
+			"status":         string(result.Status),
+			"shape":          attributes["shape"].Value,
+			"version":        result.ServiceVersion,
+			"connect_string": attributes["CONNECT_STRING"].Value,
+			"creation_date":  result.CreationDate,
+		}, nil
+	})
+}
@@ -0,0 +1,43 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDataSourceMySQLServiceInstances_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceMySQLServiceInstancesBasic(ri)
+	resourceName := "data.oraclepaas_mysql_service_instances.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "names.0", "oraclepaas_mysql_service_instance.test", "name"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "service_instances.0.name", "oraclepaas_mysql_service_instance.test", "name"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "service_instances.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMySQLServiceInstancesBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+data "oraclepaas_mysql_service_instances" "test" {
+    name_regex = "^${oraclepaas_mysql_service_instance.test.name}$"
+}`, testAccMySQLServiceInstanceCloudStorage(rInt))
+}
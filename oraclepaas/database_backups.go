@@ -0,0 +1,109 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+)
+
+// API URI Path for the backups of a database service instance
+const databaseBackupsPath = "/%s/backups"
+
+// databaseBackup is a backup of a database service instance
+type databaseBackup struct {
+	// The tag identifying the backup
+	DBTag string `json:"dbTag"`
+	// Date and time the backup completed
+	BackupCompleteDate string `json:"backupCompleteDate"`
+	// Status of the backup
+	Status string `json:"status"`
+	// Type of the backup, e.g. incremental or full
+	Type string `json:"type"`
+	// Whether the backup is kept until it's explicitly deleted, rather than following the retention policy
+	KeepForever bool `json:"keepForever"`
+}
+
+type databaseBackupList struct {
+	BackupList []databaseBackup `json:"backupList"`
+}
+
+// startDatabaseBackupInput defines the attributes for starting an on-demand backup
+type startDatabaseBackupInput struct {
+	// Keep the backup until it's explicitly deleted
+	KeepForever bool `json:"keepForever,omitempty"`
+	// Tag to identify the backup
+	BackupTag string `json:"backupTag,omitempty"`
+}
+
+func (c *psmClient) databaseBackupsPath(serviceInstanceID string) string {
+	return fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseBackupsPath, serviceInstanceID)
+}
+
+// startDatabaseBackup starts an on-demand backup of the database service instance, returning the id
+// of the backup job
+func (c *psmClient) startDatabaseBackup(serviceInstanceID string, input *startDatabaseBackupInput) (string, error) {
+	jobID, err := c.submitJob("POST", c.databaseBackupsPath(serviceInstanceID), input)
+	if err != nil {
+		return "", fmt.Errorf("unable to start backup of Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
+
+// getDatabaseBackups retrieves the available backups of the database service instance, ordered from
+// the oldest to the most recent
+func (c *psmClient) getDatabaseBackups(serviceInstanceID string) ([]databaseBackup, error) {
+	var list databaseBackupList
+	if err := c.getResource(c.databaseBackupsPath(serviceInstanceID), &list); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(list.BackupList, func(i, j int) bool {
+		return list.BackupList[i].BackupCompleteDate < list.BackupList[j].BackupCompleteDate
+	})
+
+	return list.BackupList, nil
+}
+
+// getDatabaseBackup retrieves the backup of the database service instance with the given tag,
+// returning nil if the backup doesn't exist
+func (c *psmClient) getDatabaseBackup(serviceInstanceID, tag string) (*databaseBackup, error) {
+	backups, err := c.getDatabaseBackups(serviceInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, backup := range backups {
+		if backup.DBTag == tag {
+			return &backup, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// API URI Path for restoring a database service instance
+const databaseRecoveryPath = "/%s/backups/recovery"
+
+// restoreDatabaseInput defines the attributes for restoring a database service instance. Only one of
+// the attributes can be set, restoring to the most recent backup when none are set.
+type restoreDatabaseInput struct {
+	// Restore to the most recent backup
+	Latest bool `json:"latest,omitempty"`
+	// Tag of the backup to restore
+	Tag string `json:"tag,omitempty"`
+	// System Change Number to restore to
+	SCN string `json:"scn,omitempty"`
+	// Point in time to restore to
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// restoreDatabase starts restoring the database service instance, returning the id of the recovery job
+func (c *psmClient) restoreDatabase(serviceInstanceID string, input *restoreDatabaseInput) (string, error) {
+	path := fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseRecoveryPath, serviceInstanceID)
+	jobID, err := c.submitJob("POST", path, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to restore Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
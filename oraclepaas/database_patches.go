@@ -0,0 +1,106 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/database"
+)
+
+// API URI Paths for the patches of a database service instance
+const (
+	databaseAvailablePatchesPath = "/%s/patches/available"
+	databaseAppliedPatchesPath   = "/%s/patches/applied"
+	databasePatchPath            = "/%s/patches/%s/%s"
+)
+
+// databasePatchOperation defines the constants for the operations that can be run for a patch
+type databasePatchOperation string
+
+const (
+	databasePatchOperationPrecheck databasePatchOperation = "precheck"
+	databasePatchOperationApply    databasePatchOperation = "apply"
+	databasePatchOperationRollback databasePatchOperation = "rollback"
+)
+
+// databasePatch is a patch that can be applied to a database service instance
+type databasePatch struct {
+	PatchID          string `json:"patchId"`
+	PatchNumber      string `json:"patchNumber"`
+	PatchCategory    string `json:"patchCategory"`
+	PatchDescription string `json:"patchDescription"`
+	PatchReleaseURL  string `json:"patchReleaseUrl"`
+	ReleaseVersion   string `json:"releaseVersion"`
+	EntryDate        string `json:"entryDate"`
+}
+
+type databasePatchList struct {
+	AvailablePatches []databasePatch `json:"availablePatches"`
+}
+
+type databaseAppliedPatchList struct {
+	AppliedPatches []databasePatch `json:"appliedPatches"`
+}
+
+// getDatabaseAvailablePatches retrieves the patches that can be applied to the database service instance
+func (c *psmClient) getDatabaseAvailablePatches(serviceInstanceID string) ([]databasePatch, error) {
+	path := fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseAvailablePatchesPath, serviceInstanceID)
+
+	var list databasePatchList
+	if err := c.getResource(path, &list); err != nil {
+		return nil, err
+	}
+
+	return list.AvailablePatches, nil
+}
+
+// getDatabaseAppliedPatches retrieves the patches that have been applied to the database service instance
+func (c *psmClient) getDatabaseAppliedPatches(serviceInstanceID string) ([]databasePatch, error) {
+	path := fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseAppliedPatchesPath, serviceInstanceID)
+
+	var list databaseAppliedPatchList
+	if err := c.getResource(path, &list); err != nil {
+		return nil, err
+	}
+
+	return list.AppliedPatches, nil
+}
+
+// runDatabasePatchOperation runs the precheck, apply or rollback operation for the patch on the
+// database service instance, and waits for the job to complete
+func (c *psmClient) runDatabasePatchOperation(dbClient *database.Client, serviceInstanceID, patchID string, operation databasePatchOperation, timeout time.Duration) error {
+	path := fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databasePatchPath, serviceInstanceID, patchID, operation)
+
+	jobID, err := c.submitJob("PUT", path, nil)
+	if err != nil {
+		return fmt.Errorf("unable to %s patch %q on Database Service Instance %q: %+v", operation, patchID, serviceInstanceID, err)
+	}
+
+	getJobInput := &database.GetJobInput{
+		ID: jobID,
+	}
+	if err := dbClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, timeout); err != nil {
+		return fmt.Errorf("error running %s of patch %q on Database Service Instance %q: %+v", operation, patchID, serviceInstanceID, err)
+	}
+
+	return nil
+}
+
+// applyDatabasePatch prechecks and applies the patch to the database service instance, rolling the
+// patch back if it fails to apply
+func (c *psmClient) applyDatabasePatch(dbClient *database.Client, serviceInstanceID, patchID string, timeout time.Duration) error {
+	if err := c.runDatabasePatchOperation(dbClient, serviceInstanceID, patchID, databasePatchOperationPrecheck, timeout); err != nil {
+		return err
+	}
+
+	applyErr := c.runDatabasePatchOperation(dbClient, serviceInstanceID, patchID, databasePatchOperationApply, timeout)
+	if applyErr == nil {
+		return nil
+	}
+
+	if err := c.runDatabasePatchOperation(dbClient, serviceInstanceID, patchID, databasePatchOperationRollback, timeout); err != nil {
+		return fmt.Errorf("%s\nRollback failed: %s", applyErr, err)
+	}
+
+	return fmt.Errorf("%s\nThe patch has been rolled back", applyErr)
+}
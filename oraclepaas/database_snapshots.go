@@ -0,0 +1,103 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+)
+
+// API URI Paths for the snapshots of a database service instance
+const (
+	databaseSnapshotsPath = "/%s/snapshots"
+	databaseSnapshotPath  = "/%s/snapshots/%s"
+)
+
+// databaseSnapshot is a storage snapshot of a database service instance, which can be used to create
+// "snapshot clones" of the service instance
+type databaseSnapshot struct {
+	// Name of the snapshot
+	Name string `json:"name"`
+	// Description of the snapshot
+	Description string `json:"description"`
+	// Date and time the snapshot was created
+	CreationTime string `json:"creationTime"`
+	// Status of the snapshot
+	Status string `json:"status"`
+	// Names of the service instances that have been cloned from the snapshot
+	ClonedServices []struct {
+		CloneName string `json:"cloneName"`
+	} `json:"clonedServices"`
+}
+
+type databaseSnapshotList struct {
+	Snapshots []databaseSnapshot `json:"snapshots"`
+}
+
+// createDatabaseSnapshotInput defines the attributes for creating a snapshot
+type createDatabaseSnapshotInput struct {
+	// Name of the snapshot
+	Name string `json:"name"`
+	// Description of the snapshot
+	Description string `json:"description,omitempty"`
+}
+
+func (c *psmClient) databaseSnapshotsPath(serviceInstanceID string) string {
+	return fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseSnapshotsPath, serviceInstanceID)
+}
+
+func (c *psmClient) databaseSnapshotPath(serviceInstanceID, name string) string {
+	return fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain) + fmt.Sprintf(databaseSnapshotPath, serviceInstanceID, name)
+}
+
+// createDatabaseSnapshot starts creating a snapshot of the database service instance, returning the
+// id of the snapshot job
+func (c *psmClient) createDatabaseSnapshot(serviceInstanceID string, input *createDatabaseSnapshotInput) (string, error) {
+	jobID, err := c.submitJob("POST", c.databaseSnapshotsPath(serviceInstanceID), input)
+	if err != nil {
+		return "", fmt.Errorf("unable to create snapshot %q of Database Service Instance %q: %+v", input.Name, serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
+
+// getDatabaseSnapshots retrieves the snapshots of the database service instance, ordered by name
+func (c *psmClient) getDatabaseSnapshots(serviceInstanceID string) ([]databaseSnapshot, error) {
+	var list databaseSnapshotList
+	if err := c.getResource(c.databaseSnapshotsPath(serviceInstanceID), &list); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(list.Snapshots, func(i, j int) bool {
+		return list.Snapshots[i].Name < list.Snapshots[j].Name
+	})
+
+	return list.Snapshots, nil
+}
+
+// getDatabaseSnapshot retrieves the snapshot of the database service instance with the given name,
+// returning nil if the snapshot doesn't exist
+func (c *psmClient) getDatabaseSnapshot(serviceInstanceID, name string) (*databaseSnapshot, error) {
+	snapshots, err := c.getDatabaseSnapshots(serviceInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			return &snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// deleteDatabaseSnapshot starts deleting the snapshot of the database service instance, returning the
+// id of the deletion job, or an empty id if the snapshot no longer exists. Snapshots that have been used
+// to create clones can't be deleted until the clones are deleted.
+func (c *psmClient) deleteDatabaseSnapshot(serviceInstanceID, name string) (string, error) {
+	jobID, err := c.submitDeleteJob(c.databaseSnapshotPath(serviceInstanceID, name))
+	if err != nil {
+		return "", fmt.Errorf("unable to delete snapshot %q of Database Service Instance %q: %+v", name, serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
@@ -0,0 +1,35 @@
+package oraclepaas
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteDatabaseSnapshot_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newTestPSMClient(t, server.URL, psmServiceTypeDatabase)
+	jobID, err := client.deleteDatabaseSnapshot("instance", "snapshot")
+	if err != nil {
+		t.Fatalf("Expected deleting a snapshot that no longer exists to succeed, got: %+v", err)
+	}
+	if jobID != "" {
+		t.Fatalf("Expected no job to be returned for a snapshot that no longer exists, got %q", jobID)
+	}
+}
+
+func TestDeleteDatabaseSnapshot_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newTestPSMClient(t, server.URL, psmServiceTypeDatabase)
+	if _, err := client.deleteDatabaseSnapshot("instance", "snapshot"); err == nil {
+		t.Fatalf("Expected an error deleting a snapshot that has clones")
+	}
+}
@@ -0,0 +1,99 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Infrastructures the PaaS services can be provisioned on
+const (
+	infrastructureOCI        = "OCI"
+	infrastructureOCIClassic = "OCI-Classic"
+)
+
+// serviceEndpoints are the API endpoints of the PaaS services in a region
+type serviceEndpoints struct {
+	Database    string
+	Java        string
+	Application string
+	MySQL       string
+}
+
+// psmRegions maps each region to the host segment used by the PaaS Service Manager and Application
+// Container Cloud endpoints of that region
+var psmRegions = map[string]string{
+	"us":    "us",
+	"emea":  "europe",
+	"aucom": "aucom",
+}
+
+// classicEndpoints are the service specific endpoints of the OCI-Classic regions. The MySQL service
+// is only available through PSM.
+var classicEndpoints = map[string]serviceEndpoints{
+	"us": {
+		Database: "https://dbaas.oraclecloud.com/",
+		Java:     "https://jaas.oraclecloud.com/",
+	},
+	"emea": {
+		Database: "https://dbcs.emea.oraclecloud.com/",
+		Java:     "https://jcs.emea.oraclecloud.com/",
+	},
+	"aucom": {
+		Database: "https://dbcs.aucom.oraclecloud.com/",
+		Java:     "https://jcs.aucom.oraclecloud.com/",
+	},
+}
+
+// dataCenterRegions maps the prefix of a data center, e.g. `us2` or `em3`, to its region
+var dataCenterRegions = map[string]string{
+	"us": "us",
+	"em": "emea",
+	"au": "aucom",
+}
+
+// regionForDataCenter returns the region of the given data center
+func regionForDataCenter(dataCenter string) (string, error) {
+	dataCenter = strings.ToLower(dataCenter)
+	for prefix, region := range dataCenterRegions {
+		if strings.HasPrefix(dataCenter, prefix) {
+			return region, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unable to determine the region of data center %q", dataCenter)
+}
+
+// regionEndpoints returns the API endpoints of the PaaS services in the given region. On OCI all the
+// services are managed through PSM, while OCI-Classic has separate database and java endpoints.
+func regionEndpoints(region, infrastructure string) (*serviceEndpoints, error) {
+	region = strings.ToLower(region)
+	psmRegion, ok := psmRegions[region]
+	if !ok {
+		regions := make([]string, 0, len(psmRegions))
+		for r := range psmRegions {
+			regions = append(regions, r)
+		}
+		sort.Strings(regions)
+		return nil, fmt.Errorf("Unsupported region %q, expected one of %s", region, strings.Join(regions, ", "))
+	}
+
+	psmEndpoint := fmt.Sprintf("https://psm.%s.oraclecloud.com/", psmRegion)
+	endpoints := &serviceEndpoints{
+		Database:    psmEndpoint,
+		Java:        psmEndpoint,
+		Application: fmt.Sprintf("https://apaas.%s.oraclecloud.com/", psmRegion),
+		MySQL:       psmEndpoint,
+	}
+
+	switch infrastructure {
+	case infrastructureOCI:
+	case infrastructureOCIClassic, "":
+		endpoints.Database = classicEndpoints[region].Database
+		endpoints.Java = classicEndpoints[region].Java
+	default:
+		return nil, fmt.Errorf("Unsupported infrastructure %q, expected %s or %s", infrastructure, infrastructureOCI, infrastructureOCIClassic)
+	}
+
+	return endpoints, nil
+}
@@ -0,0 +1,71 @@
+package oraclepaas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegionEndpoints(t *testing.T) {
+	cases := []struct {
+		region         string
+		infrastructure string
+		expected       serviceEndpoints
+	}{
+		{
+			region: "us",
+			expected: serviceEndpoints{
+				Database:    "https://dbaas.oraclecloud.com/",
+				Java:        "https://jaas.oraclecloud.com/",
+				Application: "https://apaas.us.oraclecloud.com/",
+				MySQL:       "https://psm.us.oraclecloud.com/",
+			},
+		},
+		{
+			region:         "EMEA",
+			infrastructure: infrastructureOCI,
+			expected: serviceEndpoints{
+				Database:    "https://psm.europe.oraclecloud.com/",
+				Java:        "https://psm.europe.oraclecloud.com/",
+				Application: "https://apaas.europe.oraclecloud.com/",
+				MySQL:       "https://psm.europe.oraclecloud.com/",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		endpoints, err := regionEndpoints(tc.region, tc.infrastructure)
+		if err != nil {
+			t.Fatalf("Error deriving the endpoints of region %q: %+v", tc.region, err)
+		}
+		if !reflect.DeepEqual(*endpoints, tc.expected) {
+			t.Fatalf("Expected %+v for region %q, got %+v", tc.expected, tc.region, *endpoints)
+		}
+	}
+
+	if _, err := regionEndpoints("apac", ""); err == nil {
+		t.Fatal("Expected an error for an unsupported region")
+	}
+}
+
+func TestRegionForDataCenter(t *testing.T) {
+	cases := map[string]string{
+		"us2": "us",
+		"US6": "us",
+		"em2": "emea",
+		"au1": "aucom",
+	}
+
+	for dataCenter, expected := range cases {
+		region, err := regionForDataCenter(dataCenter)
+		if err != nil {
+			t.Fatalf("Error determining the region of %q: %+v", dataCenter, err)
+		}
+		if region != expected {
+			t.Fatalf("Expected region %q for %q, got %q", expected, dataCenter, region)
+		}
+	}
+
+	if _, err := regionForDataCenter("zz1"); err == nil {
+		t.Fatal("Expected an error for an unknown data center")
+	}
+}
@@ -2,9 +2,14 @@ package oraclepaas
 
 import (
 	"fmt"
+	"log"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-oracle-terraform/application"
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
 	"github.com/hashicorp/go-oracle-terraform/database"
 	"github.com/hashicorp/go-oracle-terraform/java"
 	"github.com/hashicorp/go-oracle-terraform/mysql"
@@ -45,6 +50,20 @@ func getStringList(d *schema.ResourceData, key string) []string {
 	return res
 }
 
+// Helper function to get a string set from the schema, and alpha-sort it
+func getStringSet(d *schema.ResourceData, key string) []string {
+	if _, ok := d.GetOk(key); !ok {
+		return nil
+	}
+	l := d.Get(key).(*schema.Set).List()
+	res := make([]string, len(l))
+	for i, v := range l {
+		res[i] = v.(string)
+	}
+	sort.Strings(res)
+	return res
+}
+
 // Helper function to set a string list in the schema, in an alpha-sorted order.
 func setStringList(d *schema.ResourceData, key string, value []string) error {
 	sort.Strings(value)
@@ -71,12 +90,22 @@ func setIntList(d *schema.ResourceData, key string, value []int) error {
 	return d.Set(key, value)
 }
 
+// The java and mysql IP Reservation clients don't default the poll interval used when
+// waiting on the delete job, so we have to supply one
+const ipReservationDeletePollInterval = 5 * time.Second
+
+// The IP Reservation APIs only list every reservation in the identity domain, so the SDK
+// reports a reservation missing from that list with a plain error rather than a 404
+func wasIPReservationNotFoundError(err error) bool {
+	return opcClient.WasNotFoundError(err) || strings.Contains(err.Error(), "IP Reservation not found")
+}
+
 // A user may inadvertently call the database service without passing in the required parameters (because it's optional)
 // so we check to make sure that the database client has been initialized
 func getDatabaseClient(meta interface{}) (*database.Client, error) {
 	client := meta.(*OPAASClient).databaseClient
 	if client == nil {
-		return nil, fmt.Errorf("Database Client is not initialized. Make sure to use `database_endpoint` variable or `ORACLEPAAS_DATABASE_ENDPOINT` env variable")
+		return nil, fmt.Errorf("Database Client is not initialized. Make sure to set `region`, or use `database_endpoint` variable or `ORACLEPAAS_DATABASE_ENDPOINT` env variable")
 	}
 	return client, nil
 }
@@ -86,7 +115,7 @@ func getDatabaseClient(meta interface{}) (*database.Client, error) {
 func getJavaClient(meta interface{}) (*java.Client, error) {
 	client := meta.(*OPAASClient).javaClient
 	if client == nil {
-		return nil, fmt.Errorf("Java Client is not initialized. Make sure to use `java_endpoint` variable or `ORACLEPAAS_JAVA_ENDPOINT` env variable")
+		return nil, fmt.Errorf("Java Client is not initialized. Make sure to set `region`, or use `java_endpoint` variable or `ORACLEPAAS_JAVA_ENDPOINT` env variable")
 	}
 	return client, nil
 }
@@ -96,7 +125,7 @@ func getJavaClient(meta interface{}) (*java.Client, error) {
 func getApplicationClient(meta interface{}) (*application.Client, error) {
 	client := meta.(*OPAASClient).applicationClient
 	if client == nil {
-		return nil, fmt.Errorf("Application Client is not initialized. Make sure to use `application_endpoint` variable or `ORACLEPAAS_APPLICAITON_ENDPOINT` env variable")
+		return nil, fmt.Errorf("Application Client is not initialized. Make sure to set `region`, or use `application_endpoint` variable or `ORACLEPAAS_APPLICAITON_ENDPOINT` env variable")
 	}
 	return client, nil
 }
@@ -107,7 +136,85 @@ func getMySQLClient(meta interface{}) (*mysql.MySQLClient, error) {
 	client := meta.(*OPAASClient).mysqlClient
 
 	if client == nil {
-		return nil, fmt.Errorf("MySQL Client is not initialized. Make sure to use `mysql_endpoint` variable or `ORACLEPAAS_MYSQL_ENDPOINT` env variable")
+		return nil, fmt.Errorf("MySQL Client is not initialized. Make sure to set `region`, or use `mysql_endpoint` variable or `ORACLEPAAS_MYSQL_ENDPOINT` env variable")
 	}
 	return client, nil
 }
+
+// The PSM clients are created alongside the matching service client, so a missing PSM client
+// means the service's endpoint hasn't been configured
+func getPSMClient(meta interface{}, serviceType string) (*psmClient, error) {
+	client := meta.(*OPAASClient)
+	switch serviceType {
+	case psmServiceTypeDatabase:
+		if client.databasePSMClient == nil {
+			return nil, fmt.Errorf("Database Client is not initialized. Make sure to set `region`, or use `database_endpoint` variable or `ORACLEPAAS_DATABASE_ENDPOINT` env variable")
+		}
+		return client.databasePSMClient, nil
+	case psmServiceTypeJava:
+		if client.javaPSMClient == nil {
+			return nil, fmt.Errorf("Java Client is not initialized. Make sure to set `region`, or use `java_endpoint` variable or `ORACLEPAAS_JAVA_ENDPOINT` env variable")
+		}
+		return client.javaPSMClient, nil
+	case psmServiceTypeMySQL:
+		if client.mysqlPSMClient == nil {
+			return nil, fmt.Errorf("MySQL Client is not initialized. Make sure to set `region`, or use `mysql_endpoint` variable or `ORACLEPAAS_MYSQL_ENDPOINT` env variable")
+		}
+		return client.mysqlPSMClient, nil
+	case psmServiceTypeApplication:
+		if client.applicationPSMClient == nil {
+			return nil, fmt.Errorf("Application Client is not initialized. Make sure to set `region`, or use `application_endpoint` variable or `ORACLEPAAS_APPLICAITON_ENDPOINT` env variable")
+		}
+		return client.applicationPSMClient, nil
+	}
+	return nil, fmt.Errorf("Unknown service type %q", serviceType)
+}
+
+// Sets the `last_job_id` and `last_job_status` attributes from the most recent job run against the service instance.
+// The attributes are only informational, so failing to read the job leaves them unchanged rather than failing the refresh.
+func setLastJob(d *schema.ResourceData, meta interface{}, serviceType string) {
+	client, err := getPSMClient(meta, serviceType)
+	if err != nil {
+		log.Printf("[WARN] Unable to read last job for service instance %s: %+v", d.Id(), err)
+		return
+	}
+
+	job, err := client.getLatestJob(d.Id())
+	if err != nil {
+		log.Printf("[WARN] Unable to read last job for service instance %s: %+v", d.Id(), err)
+		return
+	}
+	if job == nil {
+		d.Set("last_job_id", "")
+		d.Set("last_job_status", "")
+		return
+	}
+
+	d.Set("last_job_id", job.ID)
+	d.Set("last_job_status", job.Status)
+}
+
+// The service instance isn't saved to the state when its creation job fails, so we add the details of
+// the job to the error instead
+func lastJobError(meta interface{}, serviceType, serviceName string, err error) error {
+	client, clientErr := getPSMClient(meta, serviceType)
+	if clientErr != nil {
+		return err
+	}
+
+	job, jobErr := client.getLatestJob(serviceName)
+	if jobErr != nil || job == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s\nLast job %s (%s) %s: %s", err, job.ID, job.OperationType, job.Status, job.SummaryMessage)
+}
+
+// The api returns some numbers as strings, which are empty when the value isn't set
+func atoiOrZero(s string) int {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return i
+}
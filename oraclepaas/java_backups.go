@@ -0,0 +1,42 @@
+package oraclepaas
+
+import "fmt"
+
+// API URI Path for the backup configuration of a java service instance
+const javaBackupConfigPath = "/backupconfig"
+
+// javaBackupSchedule defines when a scheduled backup runs
+type javaBackupSchedule struct {
+	// Day of the week the backup runs, e.g. SUNDAY. Incremental backups run every other day.
+	DayOfWeek string `json:"dayOfWeek,omitempty"`
+	// Hour of the day the backup runs, from 0 to 23
+	HourOfDay string `json:"hourOfDay"`
+}
+
+// javaBackupConfig is the scheduled backup configuration of a java service instance
+type javaBackupConfig struct {
+	FullBackupSchedule        *javaBackupSchedule `json:"fullBackupSchedule,omitempty"`
+	IncrementalBackupSchedule *javaBackupSchedule `json:"incrementalBackupSchedule,omitempty"`
+	// Number of days the scheduled backups are kept
+	BackupRetentionDays string `json:"backupRetentionDays,omitempty"`
+}
+
+// getJavaBackupConfig retrieves the scheduled backup configuration of the java service instance
+func (c *psmClient) getJavaBackupConfig(serviceInstanceID string) (*javaBackupConfig, error) {
+	var config javaBackupConfig
+	if err := c.getResource(c.serviceInstancePath(serviceInstanceID)+javaBackupConfigPath, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// updateJavaBackupConfig replaces the scheduled backup configuration of the java service instance
+func (c *psmClient) updateJavaBackupConfig(serviceInstanceID string, config *javaBackupConfig) error {
+	resp, err := c.executeRequest("PUT", c.serviceInstancePath(serviceInstanceID)+javaBackupConfigPath, config)
+	if err != nil {
+		return fmt.Errorf("unable to update backup configuration of Java Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	return resp.Body.Close()
+}
@@ -0,0 +1,114 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/java"
+)
+
+// API URI Paths for the patches of a java service instance
+const (
+	javaAvailablePatchesPath = "/patches/available"
+	javaAppliedPatchesPath   = "/patches/applied"
+	javaPatchPath            = "/patches/%s/%s"
+)
+
+// javaPatchOperation defines the constants for the operations that can be run for a patch
+type javaPatchOperation string
+
+const (
+	javaPatchOperationPrecheck javaPatchOperation = "precheck"
+	javaPatchOperationApply    javaPatchOperation = "apply"
+	javaPatchOperationRollback javaPatchOperation = "rollback"
+)
+
+// javaPatch is a patch that can be applied to a java service instance
+type javaPatch struct {
+	PatchID          string `json:"patchId"`
+	PatchNumber      string `json:"patchNumber"`
+	PatchType        string `json:"patchType"`
+	PatchCategory    string `json:"patchCategory"`
+	PatchDescription string `json:"patchDescription"`
+	ReleaseVersion   string `json:"releaseVersion"`
+	EntryDate        string `json:"entryDate"`
+	RequiresRestart  bool   `json:"requiresRestart"`
+	// The components patched, keyed by the component type, e.g. WLS, OTD or JDK
+	ComponentPatches map[string]interface{} `json:"componentPatches"`
+}
+
+type javaPatchList struct {
+	AvailablePatches []javaPatch `json:"availablePatches"`
+}
+
+type javaAppliedPatchList struct {
+	AppliedPatches []javaPatch `json:"appliedPatches"`
+}
+
+// components returns the sorted types of the components the patch applies to
+func (p javaPatch) components() []string {
+	components := make([]string, 0, len(p.ComponentPatches))
+	for component := range p.ComponentPatches {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	return components
+}
+
+// getJavaAvailablePatches retrieves the patches that can be applied to the java service instance
+func (c *psmClient) getJavaAvailablePatches(serviceInstanceID string) ([]javaPatch, error) {
+	var list javaPatchList
+	if err := c.getResource(c.serviceInstancePath(serviceInstanceID)+javaAvailablePatchesPath, &list); err != nil {
+		return nil, err
+	}
+
+	return list.AvailablePatches, nil
+}
+
+// getJavaAppliedPatches retrieves the patches that have been applied to the java service instance
+func (c *psmClient) getJavaAppliedPatches(serviceInstanceID string) ([]javaPatch, error) {
+	var list javaAppliedPatchList
+	if err := c.getResource(c.serviceInstancePath(serviceInstanceID)+javaAppliedPatchesPath, &list); err != nil {
+		return nil, err
+	}
+
+	return list.AppliedPatches, nil
+}
+
+// runJavaPatchOperation runs the precheck, apply or rollback operation for the patch on the
+// java service instance, and waits for the job to complete
+func (c *psmClient) runJavaPatchOperation(jClient *java.Client, serviceInstanceID, patchID string, operation javaPatchOperation, timeout time.Duration) error {
+	jobID, err := c.submitJob("POST", c.serviceInstancePath(serviceInstanceID)+fmt.Sprintf(javaPatchPath, patchID, operation), nil)
+	if err != nil {
+		return fmt.Errorf("unable to %s patch %q on Java Service Instance %q: %+v", operation, patchID, serviceInstanceID, err)
+	}
+
+	getJobInput := &java.GetJobInput{
+		ID: jobID,
+	}
+	if err := jClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, timeout); err != nil {
+		return fmt.Errorf("error running %s of patch %q on Java Service Instance %q: %+v", operation, patchID, serviceInstanceID, err)
+	}
+
+	return nil
+}
+
+// applyJavaPatch prechecks and applies the patch to the java service instance, rolling the patch
+// back if it fails to apply
+func (c *psmClient) applyJavaPatch(jClient *java.Client, serviceInstanceID, patchID string, timeout time.Duration) error {
+	if err := c.runJavaPatchOperation(jClient, serviceInstanceID, patchID, javaPatchOperationPrecheck, timeout); err != nil {
+		return err
+	}
+
+	applyErr := c.runJavaPatchOperation(jClient, serviceInstanceID, patchID, javaPatchOperationApply, timeout)
+	if applyErr == nil {
+		return nil
+	}
+
+	if err := c.runJavaPatchOperation(jClient, serviceInstanceID, patchID, javaPatchOperationRollback, timeout); err != nil {
+		return fmt.Errorf("%s\nRollback failed: %s", applyErr, err)
+	}
+
+	return fmt.Errorf("%s\nThe patch has been rolled back", applyErr)
+}
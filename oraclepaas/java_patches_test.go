@@ -0,0 +1,56 @@
+package oraclepaas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenJavaPatches_Component(t *testing.T) {
+	patches := []javaPatch{
+		{
+			PatchID: "wls",
+			ComponentPatches: map[string]interface{}{
+				"WLS": map[string]interface{}{},
+			},
+		},
+		{
+			PatchID: "wls-jdk",
+			ComponentPatches: map[string]interface{}{
+				"WLS": map[string]interface{}{},
+				"JDK": map[string]interface{}{},
+			},
+		},
+		{
+			PatchID: "otd",
+			ComponentPatches: map[string]interface{}{
+				"OTD": map[string]interface{}{},
+			},
+		},
+	}
+
+	cases := []struct {
+		component string
+		expected  []string
+	}{
+		{"", []string{"wls", "wls-jdk", "otd"}},
+		{"WLS", []string{"wls", "wls-jdk"}},
+		{"JDK", []string{"wls-jdk"}},
+		{"OTD", []string{"otd"}},
+	}
+
+	for _, tc := range cases {
+		result := flattenJavaPatches(patches, tc.component)
+		ids := make([]string, 0, len(result))
+		for _, patch := range result {
+			ids = append(ids, patch.(map[string]interface{})["patch_id"].(string))
+		}
+		if !reflect.DeepEqual(ids, tc.expected) {
+			t.Fatalf("Expected patches %v for component %q, got %v", tc.expected, tc.component, ids)
+		}
+	}
+
+	components := flattenJavaPatches(patches, "JDK")[0].(map[string]interface{})["components"]
+	if !reflect.DeepEqual(components, []string{"JDK", "WLS"}) {
+		t.Fatalf("Expected sorted components, got %v", components)
+	}
+}
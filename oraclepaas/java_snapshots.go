@@ -0,0 +1,74 @@
+package oraclepaas
+
+import (
+	"fmt"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+)
+
+// API URI Paths for the snapshots of a java service instance
+const (
+	javaSnapshotsPath = "/snapshots"
+	javaSnapshotPath  = "/snapshots/%s"
+)
+
+// javaSnapshot is a snapshot of a java service instance, which can be used to clone the service instance
+type javaSnapshot struct {
+	// Name of the snapshot
+	Name string `json:"name"`
+	// Description of the snapshot
+	Description string `json:"description"`
+	// Date and time the snapshot was created
+	CreationDate string `json:"creationDate"`
+	// Status of the snapshot
+	Status string `json:"status"`
+	// Service instances that have been cloned from the snapshot
+	Clones []struct {
+		ServiceName string `json:"serviceName"`
+	} `json:"clones"`
+}
+
+// createJavaSnapshotInput defines the attributes for creating a snapshot
+type createJavaSnapshotInput struct {
+	// Name of the snapshot
+	Name string `json:"name"`
+	// Description of the snapshot
+	Description string `json:"description,omitempty"`
+}
+
+// createJavaSnapshot starts creating a snapshot of the java service instance, returning the id of the
+// snapshot job
+func (c *psmClient) createJavaSnapshot(serviceInstanceID string, input *createJavaSnapshotInput) (string, error) {
+	jobID, err := c.submitJob("POST", c.serviceInstancePath(serviceInstanceID)+javaSnapshotsPath, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to create snapshot %q of Java Service Instance %q: %+v", input.Name, serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
+
+// getJavaSnapshot retrieves the snapshot of the java service instance with the given name, returning
+// nil if the snapshot doesn't exist
+func (c *psmClient) getJavaSnapshot(serviceInstanceID, name string) (*javaSnapshot, error) {
+	var snapshot javaSnapshot
+	if err := c.getResource(c.serviceInstancePath(serviceInstanceID)+fmt.Sprintf(javaSnapshotPath, name), &snapshot); err != nil {
+		if opcClient.WasNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// deleteJavaSnapshot starts deleting the snapshot of the java service instance, returning the id of
+// the deletion job, or an empty id if the snapshot no longer exists. Snapshots that have been used to
+// create clones can't be deleted until the clones are deleted.
+func (c *psmClient) deleteJavaSnapshot(serviceInstanceID, name string) (string, error) {
+	jobID, err := c.submitDeleteJob(c.serviceInstancePath(serviceInstanceID) + fmt.Sprintf(javaSnapshotPath, name))
+	if err != nil {
+		return "", fmt.Errorf("unable to delete snapshot %q of Java Service Instance %q: %+v", name, serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
@@ -0,0 +1,23 @@
+package oraclepaas
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteJavaSnapshot_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newTestPSMClient(t, server.URL, psmServiceTypeJava)
+	jobID, err := client.deleteJavaSnapshot("instance", "snapshot")
+	if err != nil {
+		t.Fatalf("Expected deleting a snapshot that no longer exists to succeed, got: %+v", err)
+	}
+	if jobID != "" {
+		t.Fatalf("Expected no job to be returned for a snapshot that no longer exists, got %q", jobID)
+	}
+}
@@ -0,0 +1,63 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+)
+
+// API URI Path for changing the lifecycle state of the hosts of a MySQL service instance
+const mysqlServiceInstanceLifecyclePath = "/hosts/%s"
+
+// mysqlServiceInstanceLifecycleState defines the constants for the lifecycle state of a MySQL service instance
+type mysqlServiceInstanceLifecycleState string
+
+const (
+	mysqlServiceInstanceLifecycleStateStop    mysqlServiceInstanceLifecycleState = "stop"
+	mysqlServiceInstanceLifecycleStateStart   mysqlServiceInstanceLifecycleState = "start"
+	mysqlServiceInstanceLifecycleStateRestart mysqlServiceInstanceLifecycleState = "restart"
+)
+
+// mysqlDesiredStateInput defines the attributes for changing the lifecycle state of a MySQL service instance
+type mysqlDesiredStateInput struct {
+	// Flag that specifies whether to control all the hosts of the service instance
+	AllServiceHosts bool `json:"allServiceHosts"`
+}
+
+// updateMySQLDesiredState starts stopping, starting or restarting the MySQL service instance, returning
+// the id of the lifecycle job
+func (c *psmClient) updateMySQLDesiredState(name string, lifecycleState mysqlServiceInstanceLifecycleState) (string, error) {
+	input := &mysqlDesiredStateInput{
+		AllServiceHosts: true,
+	}
+
+	path := c.serviceInstancePath(name) + fmt.Sprintf(mysqlServiceInstanceLifecyclePath, lifecycleState)
+	jobID, err := c.submitJob("POST", path, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to %s MySQL Service Instance %q: %+v", lifecycleState, name, err)
+	}
+
+	return jobID, nil
+}
+
+// waitForMySQLServiceInstanceState waits for the MySQL service instance to reach the given state,
+// returning an error if the service instance ends up in the ERROR state
+func (c *psmClient) waitForMySQLServiceInstanceState(name string, desiredState mysql.ServiceInstanceState, timeout time.Duration) error {
+	return c.client.WaitFor(fmt.Sprintf("service instance to be %s", desiredState), mysql.WaitForServiceInstanceReadyPollInterval, timeout, func() (bool, error) {
+		var info mysql.ServiceInstance
+		if err := c.getResource(c.serviceInstancePath(name), &info); err != nil {
+			return false, err
+		}
+
+		switch info.Status {
+		case desiredState:
+			return true, nil
+		case mysql.ServiceInstanceError:
+			return false, fmt.Errorf("MySQL Service Instance %q is in the %s state", name, info.Status)
+		default:
+			c.client.DebugLogString(fmt.Sprintf("ServiceInstance [%s] is %s, waiting for %s", name, info.Status, desiredState))
+			return false, nil
+		}
+	})
+}
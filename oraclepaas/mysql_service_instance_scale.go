@@ -0,0 +1,72 @@
+package oraclepaas
+
+import (
+	"fmt"
+)
+
+// API URI Path for scaling the hosts of a MySQL service instance
+const mysqlServiceInstanceScalePath = "/hosts/scale"
+
+// Usage of the additional storage added to a MySQL service instance
+const mysqlStorageUsageData = "data"
+
+// mysqlScaleInput defines the attributes for scaling the MySQL component of a service instance,
+// either to a new shape or by adding storage
+type mysqlScaleInput struct {
+	Components mysqlScaleComponents `json:"components"`
+}
+
+type mysqlScaleComponents struct {
+	MySQL mysqlScaleComponent `json:"mysql"`
+}
+
+type mysqlScaleComponent struct {
+	// Host names of the MySQL component to scale
+	Hosts []string `json:"hosts"`
+	// Desired compute shape for the hosts
+	Shape string `json:"shape,omitempty"`
+	// Amount of storage to add in GB
+	AdditionalStorage string `json:"additionalStorage,omitempty"`
+	// Usage of the added storage
+	Usage string `json:"usage,omitempty"`
+}
+
+// scaleMySQLServiceInstance starts scaling the MySQL hosts of the service instance up or down to the
+// given shape, returning the id of the scaling job
+func (c *psmClient) scaleMySQLServiceInstance(name string, hosts []string, shape string) (string, error) {
+	input := &mysqlScaleInput{
+		Components: mysqlScaleComponents{
+			MySQL: mysqlScaleComponent{
+				Hosts: hosts,
+				Shape: shape,
+			},
+		},
+	}
+
+	return c.submitMySQLScaleJob(name, input)
+}
+
+// addMySQLServiceInstanceStorage starts adding the given amount of data storage in GB to the MySQL
+// hosts of the service instance, returning the id of the scaling job
+func (c *psmClient) addMySQLServiceInstanceStorage(name string, hosts []string, additionalStorage int) (string, error) {
+	input := &mysqlScaleInput{
+		Components: mysqlScaleComponents{
+			MySQL: mysqlScaleComponent{
+				Hosts:             hosts,
+				AdditionalStorage: fmt.Sprintf("%d", additionalStorage),
+				Usage:             mysqlStorageUsageData,
+			},
+		},
+	}
+
+	return c.submitMySQLScaleJob(name, input)
+}
+
+func (c *psmClient) submitMySQLScaleJob(name string, input *mysqlScaleInput) (string, error) {
+	jobID, err := c.submitJob("POST", c.serviceInstancePath(name)+mysqlServiceInstanceScalePath, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to scale MySQL Service Instance %q: %+v", name, err)
+	}
+
+	return jobID, nil
+}
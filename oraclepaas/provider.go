@@ -1,7 +1,11 @@
 package oraclepaas
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -10,21 +14,50 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"user": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OPC_USERNAME", nil),
 				Description: "The user name for OPAAS API operations.",
 			},
 
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OPC_PASSWORD", nil),
 				Description: "The user password for OPAAS API operations.",
 			},
 
+			"oauth_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_OAUTH_CLIENT_ID", nil),
+				Description: "The client ID of the OAuth2 application to authenticate OPAAS API operations with, instead of the user and password.",
+			},
+
+			"oauth_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_OAUTH_CLIENT_SECRET", nil),
+				Description: "The client secret of the OAuth2 application.",
+			},
+
+			"oauth_token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_OAUTH_TOKEN_URL", nil),
+				Description: "The OAuth2 token endpoint, e.g. of the Identity Cloud Service (IDCS) instance.",
+			},
+
+			"oauth_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_OAUTH_SCOPE", nil),
+				Description: "The scope to request the OAuth2 token for.",
+			},
+
 			"identity_domain": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OPC_IDENTITY_DOMAIN", nil),
 				Description: "The OPAAS identity domain for API operations",
 			},
@@ -57,6 +90,29 @@ func Provider() terraform.ResourceProvider {
 				Description: "The HTTP endpoint for Oracle MySQL operations.",
 			},
 
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ORACLEPAAS_REGION", nil),
+				ValidateFunc: validation.StringInSlice([]string{"us", "emea", "aucom"}, true),
+				Description:  "The region to derive the endpoints of the services from, when they aren't set explicitly.",
+			},
+
+			"data_center": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ORACLEPAAS_DATA_CENTER", nil),
+				Description: "The data center, e.g. us2, to derive the region from when the region isn't set.",
+			},
+
+			"infrastructure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ORACLEPAAS_INFRASTRUCTURE", nil),
+				ValidateFunc: validation.StringInSlice([]string{infrastructureOCI, infrastructureOCIClassic}, false),
+				Description:  "The infrastructure of the region, either OCI or OCI-Classic (defaults to OCI-Classic).",
+			},
+
 			"max_retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -64,26 +120,118 @@ func Provider() terraform.ResourceProvider {
 				Description: "Maximum number retries to wait for a successful response when operating on resources within OPAAS (defaults to 1)",
 			},
 
+			"retry_on": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(retryClasses(), false),
+				},
+				Description: "The classes of failed requests to retry: connection, throttling, server_error and client_error (defaults to connection, throttling and server_error).",
+			},
+
+			"retry_max_elapsed_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OPC_RETRY_MAX_ELAPSED_TIME", nil),
+				ValidateFunc: validateDuration,
+				Description:  "The maximum time to spend retrying a request, e.g. 10m (defaults to no limit).",
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OPC_INSECURE", false),
 				Description: "Skip TLS Verification for self-signed certificates. Should only be used if absolutely required.",
 			},
+
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_PROXY_URL", nil),
+				Description: "The URL of the HTTP proxy to send requests through (defaults to the HTTP_PROXY and HTTPS_PROXY environment variables).",
+			},
+
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_CA_CERT_FILE", nil),
+				Description: "Path to a PEM file of CA certificates to trust in addition to the system certificates.",
+			},
+
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_CA_CERT_PEM", nil),
+				Description: "PEM encoded CA certificates to trust in addition to the system certificates.",
+			},
+
+			"client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_CLIENT_CERT_FILE", nil),
+				Description: "Path to a PEM file of the client certificate to present for mutual TLS.",
+			},
+
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_CLIENT_KEY_FILE", nil),
+				Description: "Path to a PEM file of the private key of the client certificate.",
+			},
+
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ORACLEPAAS_CONFIG_FILE", nil),
+				Description: "Path to an INI or JSON file of profiles that supply the credentials and endpoints (defaults to ~/.oraclepaas/config when a profile is set).",
+			},
+
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ORACLEPAAS_PROFILE", nil),
+				Description: "The profile of the config file to use (defaults to DEFAULT when a config file is set).",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"oraclepaas_database_service_instance": dataSourceOraclePAASDatabaseServiceInstance(),
+			"oraclepaas_database_service_instance":  dataSourceOraclePAASDatabaseServiceInstance(),
+			"oraclepaas_database_compute_nodes":     dataSourceOraclePAASDatabaseComputeNodes(),
+			"oraclepaas_job":                        dataSourceOraclePAASJob(),
+			"oraclepaas_java_service_instance":      dataSourceOraclePAASJavaServiceInstance(),
+			"oraclepaas_mysql_service_instance":     dataSourceOraclePAASMySQLServiceInstance(),
+			"oraclepaas_application_container":      dataSourceOraclePAASApplicationContainer(),
+			"oraclepaas_database_service_instances": dataSourceOraclePAASDatabaseServiceInstances(),
+			"oraclepaas_java_service_instances":     dataSourceOraclePAASJavaServiceInstances(),
+			"oraclepaas_mysql_service_instances":    dataSourceOraclePAASMySQLServiceInstances(),
+			"oraclepaas_application_containers":     dataSourceOraclePAASApplicationContainers(),
+			"oraclepaas_database_access_rules":      dataSourceOraclePAASDatabaseAccessRules(),
+			"oraclepaas_java_access_rules":          dataSourceOraclePAASJavaAccessRules(),
+			"oraclepaas_mysql_access_rules":         dataSourceOraclePAASMySQLAccessRules(),
+			"oraclepaas_database_backups":           dataSourceOraclePAASDatabaseBackups(),
+			"oraclepaas_database_patches":           dataSourceOraclePAASDatabasePatches(),
+			"oraclepaas_java_patches":               dataSourceOraclePAASJavaPatches(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"oraclepaas_java_access_rule":          resourceOraclePAASJavaAccessRule(),
+			"oraclepaas_java_ip_reservation":       resourceOraclePAASJavaIPReservation(),
 			"oraclepaas_database_service_instance": resourceOraclePAASDatabaseServiceInstance(),
 			"oraclepaas_java_service_instance":     resourceOraclePAASJavaServiceInstance(),
 			"oraclepaas_database_access_rule":      resourceOraclePAASDatabaseAccessRule(),
+			"oraclepaas_database_ip_reservation":   resourceOraclePAASDatabaseIPReservation(),
+			"oraclepaas_database_ssh_key":          resourceOraclePAASDatabaseSSHKey(),
 			"oraclepaas_application_container":     resourceOraclePAASApplicationContainer(),
 			"oraclepaas_mysql_service_instance":    resourceOraclePAASMySQLServiceInstance(),
 			"oraclepaas_mysql_access_rule":         resourceOraclePAASMySQLAccessRule(),
+			"oraclepaas_mysql_ip_reservation":      resourceOraclePAASMySQLIPReservation(),
+			"oraclepaas_database_backup":           resourceOraclePAASDatabaseBackup(),
+			"oraclepaas_database_restore":          resourceOraclePAASDatabaseRestore(),
+			"oraclepaas_java_backup":               resourceOraclePAASJavaBackup(),
+			"oraclepaas_mysql_backup":              resourceOraclePAASMySQLBackup(),
+			"oraclepaas_database_snapshot":         resourceOraclePAASDatabaseSnapshot(),
+			"oraclepaas_java_snapshot":             resourceOraclePAASJavaSnapshot(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -91,16 +239,57 @@ func Provider() terraform.ResourceProvider {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	// Settings from a config file profile apply when the argument isn't set in the provider block or
+	// through its environment variable
+	profile := make(map[string]string)
+	configFile := d.Get("config_file").(string)
+	profileName := d.Get("profile").(string)
+	if configFile != "" || profileName != "" {
+		var err error
+		if profile, err = readConfigFileProfile(configFile, profileName); err != nil {
+			return nil, err
+		}
+	}
+
+	getString := func(key string) string {
+		if v := d.Get(key).(string); v != "" {
+			return v
+		}
+		return profile[key]
+	}
+
+	var retryMaxElapsedTime time.Duration
+	if v := d.Get("retry_max_elapsed_time").(string); v != "" {
+		var err error
+		if retryMaxElapsedTime, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("Invalid retry_max_elapsed_time %q: %+v", v, err)
+		}
+	}
+
 	config := Config{
-		User:                d.Get("user").(string),
-		Password:            d.Get("password").(string),
-		IdentityDomain:      d.Get("identity_domain").(string),
-		ApplicationEndpoint: d.Get("application_endpoint").(string),
-		DatabaseEndpoint:    d.Get("database_endpoint").(string),
-		JavaEndpoint:        d.Get("java_endpoint").(string),
-		MySQLEndpoint:       d.Get("mysql_endpoint").(string),
+		User:                getString("user"),
+		Password:            getString("password"),
+		IdentityDomain:      getString("identity_domain"),
+		OAuthClientID:       getString("oauth_client_id"),
+		OAuthClientSecret:   getString("oauth_client_secret"),
+		OAuthTokenURL:       getString("oauth_token_url"),
+		OAuthScope:          getString("oauth_scope"),
+		ApplicationEndpoint: getString("application_endpoint"),
+		DatabaseEndpoint:    getString("database_endpoint"),
+		JavaEndpoint:        getString("java_endpoint"),
+		MySQLEndpoint:       getString("mysql_endpoint"),
+		Region:              getString("region"),
+		DataCenter:          getString("data_center"),
+		Infrastructure:      getString("infrastructure"),
 		MaxRetries:          d.Get("max_retries").(int),
+		RetryOn:             getStringSet(d, "retry_on"),
+		RetryMaxElapsedTime: retryMaxElapsedTime,
 		Insecure:            d.Get("insecure").(bool),
+		ProxyURL:            getString("proxy_url"),
+		CACertFile:          getString("ca_cert_file"),
+		CACertPEM:           getString("ca_cert_pem"),
+		ClientCertFile:      getString("client_cert_file"),
+		ClientKeyFile:       getString("client_key_file"),
 	}
 
 	return config.Client()
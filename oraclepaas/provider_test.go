@@ -30,17 +30,24 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
-	required := []string{"OPC_USERNAME", "OPC_PASSWORD", "OPC_IDENTITY_DOMAIN", "ORACLEPAAS_DATABASE_ENDPOINT", "ORACLEPAAS_JAVA_ENDPOINT", "ORACLEPAAS_APPLICATION_ENDPOINT", "ORACLEPAAS_MYSQL_ENDPOINT"}
+	required := []string{"OPC_IDENTITY_DOMAIN", "ORACLEPAAS_DATABASE_ENDPOINT", "ORACLEPAAS_JAVA_ENDPOINT", "ORACLEPAAS_APPLICATION_ENDPOINT", "ORACLEPAAS_MYSQL_ENDPOINT"}
 
 	for _, prop := range required {
 		if os.Getenv(prop) == "" {
 			t.Fatalf("%s must be set for acceptance test", prop)
 		}
 	}
+	if os.Getenv("OPC_OAUTH_CLIENT_ID") == "" && (os.Getenv("OPC_USERNAME") == "" || os.Getenv("OPC_PASSWORD") == "") {
+		t.Fatal("OPC_USERNAME and OPC_PASSWORD, or OPC_OAUTH_CLIENT_ID, must be set for acceptance test")
+	}
 	config := Config{
 		User:                os.Getenv("OPC_USERNAME"),
 		Password:            os.Getenv("OPC_PASSWORD"),
 		IdentityDomain:      os.Getenv("OPC_IDENTITY_DOMAIN"),
+		OAuthClientID:       os.Getenv("OPC_OAUTH_CLIENT_ID"),
+		OAuthClientSecret:   os.Getenv("OPC_OAUTH_CLIENT_SECRET"),
+		OAuthTokenURL:       os.Getenv("OPC_OAUTH_TOKEN_URL"),
+		OAuthScope:          os.Getenv("OPC_OAUTH_SCOPE"),
 		MaxRetries:          1,
 		Insecure:            false,
 		DatabaseEndpoint:    os.Getenv("ORACLEPAAS_DATABASE_ENDPOINT"),
@@ -0,0 +1,167 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+)
+
+// API URI Paths for the backups of a PSM managed service instance
+const (
+	psmBackupsPath = "/backups"
+	psmBackupPath  = "/backups/%s"
+	psmRestorePath = "/restoredbackups"
+)
+
+// psmBackup is a backup of a PSM managed service instance
+type psmBackup struct {
+	BackupID       string `json:"backupId"`
+	BackupType     string `json:"backupType"`
+	DateStarted    string `json:"dateStarted"`
+	DateCompleted  string `json:"dateCompleted"`
+	ExpirationDate string `json:"expirationDate"`
+	Notes          string `json:"notes"`
+	Status         string `json:"status"`
+}
+
+type psmBackupList struct {
+	Backups []psmBackup `json:"backups"`
+}
+
+// psmJobWaiter waits for the job with the given id to complete, using the job client of the service's SDK
+type psmJobWaiter func(jobID string, timeout time.Duration) error
+
+// startBackupInput defines the attributes for starting an on-demand backup
+type startBackupInput struct {
+	// Take a full backup, rather than an incremental backup
+	FullBackup bool `json:"fullBackup"`
+	// Notes about the backup
+	Note string `json:"note,omitempty"`
+}
+
+// startBackup starts an on-demand backup of the service instance, returning the id of the backup job
+func (c *psmClient) startBackup(serviceInstanceID string, input *startBackupInput) (string, error) {
+	jobID, err := c.submitJob("POST", c.serviceInstancePath(serviceInstanceID)+psmBackupsPath, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to start backup of service instance %q: %+v", serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
+
+// getBackups retrieves the backups of the service instance, ordered from the oldest to the most recent
+func (c *psmClient) getBackups(serviceInstanceID string) ([]psmBackup, error) {
+	var list psmBackupList
+	if err := c.getResource(c.serviceInstancePath(serviceInstanceID)+psmBackupsPath, &list); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(list.Backups, func(i, j int) bool {
+		return list.Backups[i].DateStarted < list.Backups[j].DateStarted
+	})
+
+	return list.Backups, nil
+}
+
+// createBackup takes an on-demand backup of the service instance and waits for the job to complete,
+// returning the id of the job and the backup it created
+func (c *psmClient) createBackup(serviceInstanceID string, input *startBackupInput, waitForJob psmJobWaiter, timeout time.Duration) (string, *psmBackup, error) {
+	// The backup job doesn't return the id of the backup it creates, so find the backup that
+	// didn't exist before the job ran
+	existingBackups, err := c.getBackups(serviceInstanceID)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to read backups of service instance %q: %+v", serviceInstanceID, err)
+	}
+	existingIDs := make(map[string]bool, len(existingBackups))
+	for _, backup := range existingBackups {
+		existingIDs[backup.BackupID] = true
+	}
+
+	jobID, err := c.startBackup(serviceInstanceID, input)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := waitForJob(jobID, timeout); err != nil {
+		return jobID, nil, fmt.Errorf("error backing up service instance %q: %+v", serviceInstanceID, err)
+	}
+
+	backups, err := c.getBackups(serviceInstanceID)
+	if err != nil {
+		return jobID, nil, fmt.Errorf("unable to read backups of service instance %q: %+v", serviceInstanceID, err)
+	}
+
+	// Backups are ordered from the oldest, so the last new backup is the one the job created
+	var created *psmBackup
+	for i := range backups {
+		if !existingIDs[backups[i].BackupID] {
+			created = &backups[i]
+		}
+	}
+	if created == nil {
+		return jobID, nil, fmt.Errorf("unable to find the backup created by job %s for service instance %q", jobID, serviceInstanceID)
+	}
+
+	return jobID, created, nil
+}
+
+// getBackup retrieves the backup of the service instance with the given id, returning nil if the
+// backup doesn't exist
+func (c *psmClient) getBackup(serviceInstanceID, backupID string) (*psmBackup, error) {
+	backups, err := c.getBackups(serviceInstanceID)
+	if err != nil {
+		// The service instance, and therefore the backup, does not exist
+		if opcClient.WasNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, backup := range backups {
+		if backup.BackupID == backupID {
+			return &backup, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// removeBackup deletes the backup of the service instance and waits for the job to complete
+func (c *psmClient) removeBackup(serviceInstanceID, backupID string, waitForJob psmJobWaiter, timeout time.Duration) error {
+	jobID, err := c.submitDeleteJob(c.serviceInstancePath(serviceInstanceID) + fmt.Sprintf(psmBackupPath, backupID))
+	if err != nil {
+		return fmt.Errorf("unable to delete backup %q of service instance %q: %+v", backupID, serviceInstanceID, err)
+	}
+	// The backup, or the service instance, no longer exists
+	if jobID == "" {
+		return nil
+	}
+
+	if err := waitForJob(jobID, timeout); err != nil {
+		return fmt.Errorf("error deleting backup %q of service instance %q: %+v", backupID, serviceInstanceID, err)
+	}
+
+	return nil
+}
+
+// restoreBackupInput defines the attributes for restoring a service instance from a backup
+type restoreBackupInput struct {
+	// ID of the backup to restore
+	BackupID string `json:"backupId"`
+}
+
+// restoreBackup starts restoring the service instance from the backup, returning the id of the job
+func (c *psmClient) restoreBackup(serviceInstanceID, backupID string) (string, error) {
+	input := &restoreBackupInput{
+		BackupID: backupID,
+	}
+
+	jobID, err := c.submitJob("POST", c.serviceInstancePath(serviceInstanceID)+psmRestorePath, input)
+	if err != nil {
+		return "", fmt.Errorf("unable to restore backup %q of service instance %q: %+v", backupID, serviceInstanceID, err)
+	}
+
+	return jobID, nil
+}
@@ -0,0 +1,352 @@
+package oraclepaas
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/opc"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Service types used by the PaaS Service Manager (PSM) APIs
+const (
+	psmServiceTypeDatabase = "dbaas"
+	psmServiceTypeJava     = "jaas"
+	psmServiceTypeMySQL    = "MySQLCS"
+	// Application Containers aren't managed by PSM, but their API is authenticated the same way
+	psmServiceTypeApplication = "apaas"
+)
+
+// API URI Paths for the PSM Activity Log
+const (
+	psmJobPath         = "/paas/api/v1.1/activitylog/%s/job/%s"
+	psmActivityLogPath = "/paas/api/v1.1/activitylog/%s/filter"
+)
+
+// API URI Paths for listing service instances, matching the container paths used by the SDK clients
+const (
+	psmDatabaseServiceInstancesPath = "/paas/service/dbcs/api/v1.1/instances/%s"
+	psmServiceInstancesPath         = "/paas/api/v1.1/instancemgmt/%s/services/%s/instances"
+	psmApplicationContainersPath    = "/paas/service/apaas/api/v1.1/apps/%s"
+)
+
+// API URI Path for a single service instance managed by PSM
+const psmServiceInstancePath = "/paas/api/v1.1/instancemgmt/%s/services/%s/instances/%s"
+
+// Default Poll Interval value for jobs submitted by the psmClient
+const psmJobPollInterval = 30 * time.Second
+
+// API URI Path for the Access Rules of a service instance
+const psmAccessRulesPath = "/paas/api/v1.1/instancemgmt/%s/services/%s/instances/%s/accessrules"
+
+// psmClient calls the PaaS Service Manager APIs that are shared by the database, java and mysql
+// services but aren't exposed by go-oracle-terraform. The SDK client is reused for the endpoint,
+// user agent, retries and logging.
+type psmClient struct {
+	client      *opcClient.Client
+	serviceType string
+	authHeader  string
+}
+
+func newPSMClient(c *opc.Config, serviceType string) (*psmClient, error) {
+	client, err := opcClient.NewClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	usernamePassword := []byte(fmt.Sprintf("%s:%s", *c.Username, *c.Password))
+
+	return &psmClient{
+		client:      client,
+		serviceType: serviceType,
+		authHeader:  fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString(usernamePassword)),
+	}, nil
+}
+
+func (c *psmClient) executeRequest(method, path string, body interface{}) (*http.Response, error) {
+	reqBody, err := c.client.MarshallRequestBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.client.BuildRequestBody(method, path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	debugReqString := fmt.Sprintf("HTTP %s Req (%s)", method, path)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+		debugReqString = fmt.Sprintf("%s:\nBody: %+v", debugReqString, string(reqBody))
+	}
+	// Log the request before the authentication header, so as not to leak credentials
+	c.client.DebugLogString(debugReqString)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Add("Authorization", c.authHeader)
+	req.Header.Add("X-ID-TENANT-NAME", *c.client.IdentityDomain)
+
+	return c.client.ExecuteRequest(req)
+}
+
+func (c *psmClient) getResource(path string, responseBody interface{}) error {
+	resp, err := c.executeRequest("GET", path, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.unmarshalResponseBody(resp, responseBody)
+}
+
+func (c *psmClient) unmarshalResponseBody(resp *http.Response, iface interface{}) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return err
+	}
+	c.client.DebugLogString(fmt.Sprintf("HTTP Resp (%d): %s", resp.StatusCode, buf.String()))
+
+	var tmp interface{}
+	if err := json.NewDecoder(buf).Decode(&tmp); err != nil {
+		return fmt.Errorf("Error decoding: %s\n%+v", err.Error(), resp)
+	}
+
+	// The PSM APIs aren't consistent about returning numbers as strings, so decode weakly
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           iface,
+		TagName:          "json",
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(tmp)
+}
+
+// psmJob is a job from the PSM Activity Log
+type psmJob struct {
+	ID             string          `json:"jobId"`
+	Status         string          `json:"status"`
+	OperationType  string          `json:"operationType"`
+	SummaryMessage string          `json:"summaryMessage"`
+	ServiceName    string          `json:"serviceName"`
+	ServiceType    string          `json:"serviceType"`
+	InitiatedBy    string          `json:"initiatedBy"`
+	StartDate      string          `json:"startDate"`
+	EndDate        string          `json:"endDate"`
+	Messages       []psmJobMessage `json:"messages"`
+}
+
+type psmJobMessage struct {
+	ActivityDate string `json:"activityDate"`
+	Message      string `json:"message"`
+}
+
+type psmActivityLog struct {
+	ActivityLogs []psmJob `json:"activityLogs"`
+}
+
+// getJob retrieves the job with the given id
+func (c *psmClient) getJob(id string) (*psmJob, error) {
+	var job psmJob
+	if err := c.getResource(fmt.Sprintf(psmJobPath, *c.client.IdentityDomain, id), &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// psmJobResponse details the job information received after submitting a request
+type psmJobResponse struct {
+	Details struct {
+		JobID   string `json:"jobId"`
+		Message string `json:"message"`
+	} `json:"details"`
+}
+
+// submitJob sends a request that starts a job against a service instance, returning the id of the job
+func (c *psmClient) submitJob(method, path string, body interface{}) (string, error) {
+	resp, err := c.executeRequest(method, path, body)
+	if err != nil {
+		return "", err
+	}
+
+	var jobResponse psmJobResponse
+	if err := c.unmarshalResponseBody(resp, &jobResponse); err != nil {
+		return "", err
+	}
+
+	// Waiting for a job without an id would poll until the timeout
+	if jobResponse.Details.JobID == "" {
+		return "", fmt.Errorf("no job id was returned by %s %s", method, path)
+	}
+
+	return jobResponse.Details.JobID, nil
+}
+
+// submitDeleteJob sends a request that starts deleting a resource of a service instance, returning the id
+// of the job, or an empty id if the resource, or the service instance, no longer exists
+func (c *psmClient) submitDeleteJob(path string) (string, error) {
+	jobID, err := c.submitJob("DELETE", path, nil)
+	if err != nil {
+		if opcClient.WasNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return jobID, nil
+}
+
+// serviceInstancePath returns the path of the given PSM managed service instance
+func (c *psmClient) serviceInstancePath(name string) string {
+	return fmt.Sprintf(psmServiceInstancePath, *c.client.IdentityDomain, c.serviceType, name)
+}
+
+// getLatestJob retrieves the most recent job run against the given service instance,
+// returning nil if no jobs have been run against it
+func (c *psmClient) getLatestJob(serviceName string) (*psmJob, error) {
+	query := url.Values{}
+	query.Set("serviceName", serviceName)
+	query.Set("serviceType", c.serviceType)
+	query.Set("limit", "1")
+
+	path := fmt.Sprintf("%s?%s", fmt.Sprintf(psmActivityLogPath, *c.client.IdentityDomain), query.Encode())
+
+	var activityLog psmActivityLog
+	if err := c.getResource(path, &activityLog); err != nil {
+		return nil, err
+	}
+
+	if len(activityLog.ActivityLogs) == 0 {
+		return nil, nil
+	}
+
+	return &activityLog.ActivityLogs[0], nil
+}
+
+// psmServiceInstanceSummary is a service instance as returned when listing the service instances
+type psmServiceInstanceSummary struct {
+	Name string
+	Tags map[string]string
+	// Runtime of an application container, e.g. java or node
+	Runtime string
+}
+
+type psmServiceInstanceList struct {
+	Services []struct {
+		ServiceName string `json:"serviceName"`
+		// The database service uses snake case
+		DatabaseServiceName string      `json:"service_name"`
+		Tags                interface{} `json:"tags"`
+	} `json:"services"`
+	Applications []struct {
+		Name    string      `json:"name"`
+		Runtime string      `json:"runtime"`
+		Tags    interface{} `json:"tags"`
+	} `json:"applications"`
+}
+
+// listServiceInstances retrieves the names and tags of all the service instances in the identity domain
+func (c *psmClient) listServiceInstances() ([]psmServiceInstanceSummary, error) {
+	var path string
+	switch c.serviceType {
+	case psmServiceTypeDatabase:
+		path = fmt.Sprintf(psmDatabaseServiceInstancesPath, *c.client.IdentityDomain)
+	case psmServiceTypeApplication:
+		path = fmt.Sprintf(psmApplicationContainersPath, *c.client.IdentityDomain)
+	default:
+		path = fmt.Sprintf(psmServiceInstancesPath, *c.client.IdentityDomain, c.serviceType)
+	}
+
+	var list psmServiceInstanceList
+	if err := c.getResource(path, &list); err != nil {
+		return nil, err
+	}
+
+	result := make([]psmServiceInstanceSummary, 0, len(list.Services)+len(list.Applications))
+	for _, service := range list.Services {
+		name := service.ServiceName
+		if name == "" {
+			name = service.DatabaseServiceName
+		}
+		result = append(result, psmServiceInstanceSummary{
+			Name: name,
+			Tags: expandPSMTags(service.Tags),
+		})
+	}
+	for _, application := range list.Applications {
+		result = append(result, psmServiceInstanceSummary{
+			Name:    application.Name,
+			Tags:    expandPSMTags(application.Tags),
+			Runtime: application.Runtime,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// getServiceInstanceSummary retrieves the summary of the service instance with the given name, returning nil if the
+// service instance doesn't exist
+func (c *psmClient) getServiceInstanceSummary(name string) (*psmServiceInstanceSummary, error) {
+	summaries, err := c.listServiceInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, summary := range summaries {
+		if summary.Name == name {
+			return &summary, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Tags are either returned as `{"items": [{"key": "k", "value": "v"}]}`, a list of key/value
+// objects, or a plain map depending on the service
+func expandPSMTags(v interface{}) map[string]string {
+	tags := make(map[string]string)
+
+	if m, ok := v.(map[string]interface{}); ok {
+		if items, ok := m["items"]; ok {
+			v = items
+		} else {
+			for key, value := range m {
+				tags[key] = fmt.Sprintf("%v", value)
+			}
+			return tags
+		}
+	}
+
+	if items, ok := v.([]interface{}); ok {
+		for _, item := range items {
+			if tag, ok := item.(map[string]interface{}); ok {
+				key, _ := tag["key"].(string)
+				value, _ := tag["value"].(string)
+				if key != "" {
+					tags[key] = value
+				}
+			}
+		}
+	}
+
+	return tags
+}
+
+// getAccessRules retrieves every access rule of the given service instance, decoding them into the
+// access rule list type of the matching SDK package
+func (c *psmClient) getAccessRules(serviceInstanceID string, accessRules interface{}) error {
+	return c.getResource(fmt.Sprintf(psmAccessRulesPath, *c.client.IdentityDomain, c.serviceType, serviceInstanceID), accessRules)
+}
@@ -0,0 +1,76 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-oracle-terraform/opc"
+)
+
+// newTestPSMClient returns a psmClient that sends its requests to the test server, without retries
+func newTestPSMClient(t *testing.T, serverURL, serviceType string) *psmClient {
+	endpoint, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatalf("Error parsing test server url: %+v", err)
+	}
+
+	client, err := newPSMClient(&opc.Config{
+		IdentityDomain: opc.String("domain"),
+		Username:       opc.String("user"),
+		Password:       opc.String("password"),
+		APIEndpoint:    endpoint,
+		HTTPClient:     http.DefaultClient,
+		MaxRetries:     opc.Int(1),
+	}, serviceType)
+	if err != nil {
+		t.Fatalf("Error creating PSM client: %+v", err)
+	}
+
+	return client
+}
+
+func TestGetServiceInstanceSummary_Application(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"applications": [
+			{"name": "other", "runtime": "node"},
+			{"name": "app", "runtime": "java", "tags": {"items": [{"key": "env", "value": "test"}]}}
+		]}`)
+	}))
+	defer server.Close()
+
+	client := newTestPSMClient(t, server.URL, psmServiceTypeApplication)
+	summary, err := client.getServiceInstanceSummary("app")
+	if err != nil {
+		t.Fatalf("Error reading application summary: %+v", err)
+	}
+
+	expected := &psmServiceInstanceSummary{
+		Name:    "app",
+		Tags:    map[string]string{"env": "test"},
+		Runtime: "java",
+	}
+	if !reflect.DeepEqual(summary, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, summary)
+	}
+
+	if summary, err := client.getServiceInstanceSummary("missing"); err != nil || summary != nil {
+		t.Fatalf("Expected no summary for a missing application, got %#v, %+v", summary, err)
+	}
+}
+
+func TestSubmitJob_MissingJobID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"details": {"message": "Submitted"}}`)
+	}))
+	defer server.Close()
+
+	client := newTestPSMClient(t, server.URL, psmServiceTypeJava)
+	if _, err := client.submitJob("POST", "/jobs", nil); err == nil {
+		t.Fatalf("Expected an error when no job id is returned")
+	}
+}
@@ -0,0 +1,171 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The Backup API only allows an on-demand backup to be started and the backups to be listed. Backups are
+// removed by the service once they fall outside of the retention policy, so deleting the resource only
+// removes it from state.
+func resourceOraclePAASDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASDatabaseBackupCreate,
+		Read:   resourceOraclePAASDatabaseBackupRead,
+		Delete: resourceOraclePAASDatabaseBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_forever": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASDatabaseBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Print("[DEBUG] Creating database backup")
+
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	// The backup job doesn't return the tag of the backup it creates, so find the backup that
+	// didn't exist before the job ran
+	existingBackups, err := client.getDatabaseBackups(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading backups of Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+	existingTags := make(map[string]bool, len(existingBackups))
+	for _, backup := range existingBackups {
+		existingTags[backup.DBTag] = true
+	}
+
+	input := &startDatabaseBackupInput{
+		KeepForever: d.Get("keep_forever").(bool),
+		BackupTag:   d.Get("tag").(string),
+	}
+
+	jobID, err := client.startDatabaseBackup(serviceInstanceID, input)
+	if err != nil {
+		return err
+	}
+	d.Set("job_id", jobID)
+
+	getJobInput := &database.GetJobInput{
+		ID: jobID,
+	}
+	if err := dbClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return lastJobError(meta, psmServiceTypeDatabase, serviceInstanceID, fmt.Errorf("Error backing up Database Service Instance %q: %+v", serviceInstanceID, err))
+	}
+
+	backups, err := client.getDatabaseBackups(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading backups of Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	// Backups are ordered from the oldest, so the last new backup is the one the job created
+	for _, backup := range backups {
+		if existingTags[backup.DBTag] {
+			continue
+		}
+		if input.BackupTag != "" && backup.DBTag != input.BackupTag {
+			continue
+		}
+		d.SetId(backup.DBTag)
+	}
+
+	if d.Id() == "" {
+		return fmt.Errorf("Unable to find the backup created by job %s for Database Service Instance %q", jobID, serviceInstanceID)
+	}
+
+	return resourceOraclePAASDatabaseBackupRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseBackupRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Reading state of backup %q for database service instance %q", d.Id(), serviceInstanceID)
+	result, err := client.getDatabaseBackup(serviceInstanceID, d.Id())
+	if err != nil {
+		// The service instance, and therefore the backup, does not exist
+		if opcClient.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading backup %q of Database Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	// The backup has been removed by the retention policy
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backup_id", result.DBTag)
+	d.Set("completion_date", result.BackupCompleteDate)
+	// keep_forever and tag are only used to start the backup, so they're kept as configured
+	d.Set("status", result.Status)
+	d.Set("type", result.Type)
+
+	return nil
+}
+
+func resourceOraclePAASDatabaseBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing backup %q for database service instance %q from state. The Backup API does not support deletion.", d.Id(), d.Get("service_instance_id").(string))
+	d.SetId("")
+	return nil
+}
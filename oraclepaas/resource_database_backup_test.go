@@ -0,0 +1,64 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASDatabaseBackup_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDatabaseBackupBasic(ri)
+	resourceName := "oraclepaas_database_backup.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseBackupExists,
+					resource.TestCheckResourceAttrSet(
+						resourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "completion_date"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseBackupExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).databasePSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_database_backup" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		backup, err := client.getDatabaseBackup(serviceInstanceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Database Backup %q for %q: %+v", rs.Primary.ID, serviceInstanceID, err)
+		}
+		if backup == nil {
+			return fmt.Errorf("Database Backup %q for %q does not exist", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseBackupBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_database_backup" "test" {
+    service_instance_id = "${oraclepaas_database_service_instance.test.name}"
+}`, testAccDatabaseServiceInstanceCloudStorage(rInt))
+}
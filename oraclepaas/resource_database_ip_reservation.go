@@ -0,0 +1,162 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOraclePAASDatabaseIPReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASDatabaseIPReservationCreate,
+		Read:   resourceOraclePAASDatabaseIPReservationRead,
+		Delete: resourceOraclePAASDatabaseIPReservationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"compute_site_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASDatabaseIPReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Creating database ip reservation")
+
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.IPReservationClient()
+	client.Timeout = d.Timeout(schema.TimeoutCreate)
+
+	input := database.CreateIPReservationInput{
+		Name:   d.Get("name").(string),
+		Region: d.Get("region").(string),
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = v.(string)
+	}
+
+	info, err := client.CreateIPReservation(&input)
+	if err != nil {
+		return fmt.Errorf("Error creating Database IP Reservation: %+v", err)
+	}
+
+	d.SetId(info.Name)
+
+	return resourceOraclePAASDatabaseIPReservationRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseIPReservationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.IPReservationClient()
+
+	log.Printf("[DEBUG] Reading state of database ip reservation %q", d.Id())
+	result, err := client.GetIPReservation(d.Id())
+	if err != nil {
+		// IPReservation does not exist
+		if wasIPReservationNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading database ip reservation %q: %+v", d.Id(), err)
+	}
+
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read state of database ip reservation %q: %#v", d.Id(), result)
+	d.Set("name", result.Name)
+	d.Set("network_type", result.NetworkType)
+	d.Set("compute_site_name", result.ComputeSiteName)
+	d.Set("hostname", result.Hostname)
+	d.Set("identity_domain", result.IdentityDomain)
+	d.Set("ip_address", result.IPAddress)
+	d.Set("service_name", result.ServiceName)
+	d.Set("status", result.Status)
+
+	// The API doesn't return the region, only the compute site the reservation lives in,
+	// so we only fall back to that when the region isn't already known (e.g. on import)
+	if _, ok := d.GetOk("region"); !ok {
+		d.Set("region", result.ComputeSiteName)
+	}
+
+	return nil
+}
+
+func resourceOraclePAASDatabaseIPReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Deleting database ip reservation")
+
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.IPReservationClient()
+	client.Timeout = d.Timeout(schema.TimeoutDelete)
+
+	if err := client.DeleteIPReservation(d.Id()); err != nil {
+		return fmt.Errorf("Error deleting Database IP Reservation %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// A restore is an action rather than an object, so the resource tracks the recovery job. Changing any
+// of the arguments restores the service instance again, and deleting the resource only removes it from state.
+func resourceOraclePAASDatabaseRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASDatabaseRestoreCreate,
+		Read:   resourceOraclePAASDatabaseRestoreRead,
+		Delete: resourceOraclePAASDatabaseRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"scn", "timestamp"},
+			},
+			"scn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"backup_id", "timestamp"},
+			},
+			"timestamp": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"backup_id", "scn"},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASDatabaseRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Print("[DEBUG] Restoring database service instance")
+
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	input := &restoreDatabaseInput{
+		Tag:       d.Get("backup_id").(string),
+		SCN:       d.Get("scn").(string),
+		Timestamp: d.Get("timestamp").(string),
+	}
+	if input.Tag == "" && input.SCN == "" && input.Timestamp == "" {
+		input.Latest = true
+	}
+
+	jobID, err := client.restoreDatabase(serviceInstanceID, input)
+	if err != nil {
+		return err
+	}
+	d.SetId(jobID)
+
+	getJobInput := &database.GetJobInput{
+		ID: jobID,
+	}
+	if err := dbClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return lastJobError(meta, psmServiceTypeDatabase, serviceInstanceID, fmt.Errorf("Error restoring Database Service Instance %q: %+v", serviceInstanceID, err))
+	}
+
+	// Wait for the service instance to be available again before the dependent resources are refreshed
+	getInput := &database.GetServiceInstanceInput{
+		Name: serviceInstanceID,
+	}
+	if _, err := dbClient.ServiceInstanceClient().WaitForServiceInstanceState(getInput, database.ServiceInstanceLifecycleStateStart, psmJobPollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for Database Service Instance %q to be restored: %+v", serviceInstanceID, err)
+	}
+
+	return resourceOraclePAASDatabaseRestoreRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading state of restore job %q", d.Id())
+	result, err := client.getJob(d.Id())
+	if err != nil {
+		// The job is no longer in the activity log. Keep the resource, rather than restoring the
+		// service instance again.
+		if opcClient.WasNotFoundError(err) {
+			log.Printf("[DEBUG] Restore job %q not found, keeping the last known state", d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error reading restore job %q: %+v", d.Id(), err)
+	}
+
+	d.Set("end_date", result.EndDate)
+	d.Set("start_date", result.StartDate)
+	d.Set("status", result.Status)
+
+	return nil
+}
+
+func resourceOraclePAASDatabaseRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing restore job %q from state. A restore can't be undone.", d.Id())
+	d.SetId("")
+	return nil
+}
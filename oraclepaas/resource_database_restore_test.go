@@ -0,0 +1,41 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPAASDatabaseRestore_Backup(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDatabaseRestoreBackup(ri)
+	resourceName := "oraclepaas_database_restore.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "status", "SUCCEED"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "backup_id", "oraclepaas_database_backup.test", "backup_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseRestoreBackup(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_database_restore" "test" {
+    service_instance_id = "${oraclepaas_database_backup.test.service_instance_id}"
+    backup_id = "${oraclepaas_database_backup.test.backup_id}"
+}`, testAccDatabaseBackupBasic(rInt))
+}
@@ -86,7 +86,6 @@ func resourceOraclePAASDatabaseServiceInstance() *schema.Resource {
 			"ssh_public_key": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"database_configuration": {
 				Type:     schema.TypeList,
@@ -479,6 +478,16 @@ func resourceOraclePAASDatabaseServiceInstance() *schema.Resource {
 					string(database.ServiceInstanceLifecycleStateStart),
 				}, true),
 			},
+			"patch_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"patch_version"},
+			},
+			"patch_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"patch_id"},
+			},
 			"cloud_storage_container": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -508,6 +517,14 @@ func resourceOraclePAASDatabaseServiceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -595,7 +612,7 @@ func resourceOPAASDatabaseServiceInstanceCreate(d *schema.ResourceData, meta int
 
 	info, err := client.CreateServiceInstance(&input)
 	if err != nil {
-		return fmt.Errorf("Error creating DatabaseServiceInstance: %+v", err)
+		return lastJobError(meta, psmServiceTypeDatabase, input.Name, fmt.Errorf("Error creating DatabaseServiceInstance: %+v", err))
 	}
 
 	d.SetId(info.Name)
@@ -664,6 +681,10 @@ func resourceOPAASDatabaseServiceInstanceRead(d *schema.ResourceData, meta inter
 
 	flattenAttributesFromConfig(d)
 
+	if err := readDatabasePatch(d, meta); err != nil {
+		log.Printf("[WARN] Unable to read the applied patches of database service instance %s: %+v", d.Id(), err)
+	}
+
 	// Obtain and set the default Access Rules
 	getDefaultAccessRulesInput := &database.GetDefaultAccessRuleInput{
 		ServiceInstanceID: d.Id(),
@@ -680,6 +701,8 @@ func resourceOPAASDatabaseServiceInstanceRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error setting Database Configuration: %+v", err)
 	}
 
+	setLastJob(d, meta, psmServiceTypeDatabase)
+
 	return nil
 }
 
@@ -743,6 +766,14 @@ func resourceOPAASDatabaseServiceInstanceUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
+	// The key is passed when the service instance is created, but an imported service instance has no key in
+	// the state, so a change is applied whenever the service instance already exists
+	if d.HasChange("ssh_public_key") && !d.IsNewResource() {
+		if err := setDatabaseSSHKey(meta, d.Id(), d.Get("ssh_public_key").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	if o, n := d.GetChange("database_configuration.0.data_storage_volume_size"); o.(int) != n.(int) {
 		newVolumeSize := n.(int) - o.(int)
 
@@ -779,6 +810,21 @@ func resourceOPAASDatabaseServiceInstanceUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("patch_id") || d.HasChange("patch_version") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		if err := updateDatabasePatch(d, meta, timeout); err != nil {
+			// The patch hasn't been applied, so keep the previous values in the state
+			oldPatchID, _ := d.GetChange("patch_id")
+			oldPatchVersion, _ := d.GetChange("patch_version")
+			d.Set("patch_id", oldPatchID)
+			d.Set("patch_version", oldPatchVersion)
+			return err
+		}
+	}
+
 	err = updateDefaultAccessRules(d, meta)
 	if err != nil {
 		return fmt.Errorf("Unable to update Default Access Rules: %+v", err)
@@ -786,6 +832,46 @@ func resourceOPAASDatabaseServiceInstanceUpdate(d *schema.ResourceData, meta int
 	return resourceOPAASDatabaseServiceInstanceRead(d, meta)
 }
 
+// updateDatabasePatch applies the configured patch, looking up the patch id from the available patches
+// when only the patch version is set
+func updateDatabasePatch(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	patchID := d.Get("patch_id").(string)
+	if patchVersion := d.Get("patch_version").(string); patchVersion != "" {
+		patches, err := client.getDatabaseAvailablePatches(d.Id())
+		if err != nil {
+			return fmt.Errorf("Unable to read available patches for Service Instance %q: %+v", d.Id(), err)
+		}
+		for _, patch := range patches {
+			if patch.ReleaseVersion == patchVersion {
+				patchID = patch.PatchID
+			}
+		}
+		if patchID == "" {
+			return fmt.Errorf("No patch with version %q is available for Service Instance %q", patchVersion, d.Id())
+		}
+	}
+
+	// The patch has been removed from the configuration, which doesn't change the service instance
+	if patchID == "" {
+		return nil
+	}
+
+	if err := client.applyDatabasePatch(dbClient, d.Id(), patchID, timeout); err != nil {
+		return lastJobError(meta, psmServiceTypeDatabase, d.Id(), fmt.Errorf("Unable to patch Service Instance %q: %+v", d.Id(), err))
+	}
+
+	return nil
+}
+
 func updateDefaultAccessRules(d *schema.ResourceData, meta interface{}) error {
 	dbClient, err := getDatabaseClient(meta)
 	if err != nil {
@@ -1034,3 +1120,37 @@ func flattenDatabaseConfig(d *schema.ResourceData) []interface{} {
 
 	return []interface{}{result}
 }
+
+// readDatabasePatch keeps the configured patch in the state only while it's applied to the service instance,
+// so that a patch that has failed or been rolled back shows up as a change
+func readDatabasePatch(d *schema.ResourceData, meta interface{}) error {
+	patchID := d.Get("patch_id").(string)
+	patchVersion := d.Get("patch_version").(string)
+	if patchID == "" && patchVersion == "" {
+		return nil
+	}
+
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	patches, err := client.getDatabaseAppliedPatches(d.Id())
+	if err != nil {
+		return err
+	}
+
+	appliedPatchID, appliedPatchVersion := "", ""
+	for _, patch := range patches {
+		if patchID != "" && patch.PatchID == patchID {
+			appliedPatchID = patchID
+		}
+		if patchVersion != "" && patch.ReleaseVersion == patchVersion {
+			appliedPatchVersion = patchVersion
+		}
+	}
+
+	d.Set("patch_id", appliedPatchID)
+	d.Set("patch_version", appliedPatchVersion)
+	return nil
+}
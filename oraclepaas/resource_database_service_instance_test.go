@@ -180,6 +180,63 @@ func TestAccOPAASDatabaseServiceInstance_UpdateVolumes(t *testing.T) {
 	})
 }
 
+func TestAccOPAASDatabaseServiceInstance_UpdateSSHKey(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDatabaseServiceInstanceBasic(ri)
+	config2 := testAccDatabaseServiceInstanceUpdateSSHKey(ri)
+	resourceName := "oraclepaas_database_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseServiceInstanceExists,
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "ssh_public_key", testAccDatabaseSSHKeyRotated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOPAASDatabaseServiceInstance_Patch(t *testing.T) {
+	patchID := os.Getenv("TEST_DATABASE_PATCH_ID")
+	if patchID == "" {
+		t.Skip("Missing Environment Parameter `TEST_DATABASE_PATCH_ID`. You will need to set it to a patch available for 12.2.0.1 to run this test.")
+	}
+
+	ri := acctest.RandInt()
+	resourceName := "oraclepaas_database_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseServiceInstanceBasic(ri),
+				Check:  testAccCheckDatabaseServiceInstanceExists,
+			},
+			{
+				Config: testAccDatabaseServiceInstancePatch(ri, patchID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "patch_id", patchID),
+				),
+			},
+		},
+	})
+}
+
 // An OCI account is need to test this
 /*
 func TestAccOPAASDatabaseServiceInstance_HDG(t *testing.T) {
@@ -266,6 +323,28 @@ resource "oraclepaas_database_service_instance" "test" {
 }`, rInt)
 }
 
+func testAccDatabaseServiceInstancePatch(rInt int, patchID string) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_database_service_instance" "test" {
+    name        = "test-service-instance-%d"
+    description = "test service instance"
+    edition = "EE"
+    level = "PAAS"
+    shape = "oc3"
+    subscription_type = "HOURLY"
+    version = "12.2.0.1"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+    patch_id = "%s"
+
+    database_configuration {
+        admin_password = "Test_String7"
+        backup_destination = "NONE"
+        sid = "ORCL"
+        usable_storage = 15
+    }
+}`, rInt, patchID)
+}
+
 func testAccDatabaseServiceInstanceUpdateShape(rInt int) string {
 	return fmt.Sprintf(`
 resource "oraclepaas_database_service_instance" "test" {
@@ -288,6 +367,27 @@ resource "oraclepaas_database_service_instance" "test" {
 }`, rInt)
 }
 
+func testAccDatabaseServiceInstanceUpdateSSHKey(rInt int) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_database_service_instance" "test" {
+    name        = "test-service-instance-%d"
+    description = "test service instance"
+    edition = "EE"
+    level = "PAAS"
+    shape = "oc3"
+    subscription_type = "HOURLY"
+    version = "12.2.0.1"
+    ssh_public_key = "%s"
+
+    database_configuration {
+        admin_password = "Test_String7"
+        backup_destination = "NONE"
+        sid = "ORCL"
+        usable_storage = 15
+    }
+}`, rInt, testAccDatabaseSSHKeyRotated)
+}
+
 func testAccDatabaseServiceInstanceCloudStorage(rInt int) string {
 	return fmt.Sprintf(`
 resource "oraclepaas_database_service_instance" "test" {
@@ -0,0 +1,167 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOraclePAASDatabaseSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASDatabaseSnapshotCreate,
+		Read:   resourceOraclePAASDatabaseSnapshotRead,
+		Delete: resourceOraclePAASDatabaseSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cloned_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASDatabaseSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Print("[DEBUG] Creating database snapshot")
+
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	input := &createDatabaseSnapshotInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	jobID, err := client.createDatabaseSnapshot(serviceInstanceID, input)
+	if err != nil {
+		return err
+	}
+	d.SetId(input.Name)
+	d.Set("job_id", jobID)
+
+	getJobInput := &database.GetJobInput{
+		ID: jobID,
+	}
+	if err := dbClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return lastJobError(meta, psmServiceTypeDatabase, serviceInstanceID, fmt.Errorf("Error creating snapshot %q of Database Service Instance %q: %+v", input.Name, serviceInstanceID, err))
+	}
+
+	return resourceOraclePAASDatabaseSnapshotRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Reading state of snapshot %q for database service instance %q", d.Id(), serviceInstanceID)
+	result, err := client.getDatabaseSnapshot(serviceInstanceID, d.Id())
+	if err != nil {
+		// The service instance, and therefore the snapshot, does not exist
+		if opcClient.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading snapshot %q of Database Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	clonedServices := make([]string, 0, len(result.ClonedServices))
+	for _, clone := range result.ClonedServices {
+		clonedServices = append(clonedServices, clone.CloneName)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("description", result.Description)
+	if err := d.Set("cloned_services", clonedServices); err != nil {
+		return err
+	}
+	d.Set("creation_time", result.CreationTime)
+	d.Set("status", result.Status)
+
+	return nil
+}
+
+func resourceOraclePAASDatabaseSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeDatabase)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Deleting snapshot %q of database service instance %q", d.Id(), serviceInstanceID)
+	jobID, err := client.deleteDatabaseSnapshot(serviceInstanceID, d.Id())
+	if err != nil {
+		return err
+	}
+	// The snapshot, or the service instance, no longer exists
+	if jobID == "" {
+		return nil
+	}
+
+	getJobInput := &database.GetJobInput{
+		ID: jobID,
+	}
+	if err := dbClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error deleting snapshot %q of Database Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	return nil
+}
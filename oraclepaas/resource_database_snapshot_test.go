@@ -0,0 +1,68 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASDatabaseSnapshot_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDatabaseSnapshotBasic(ri)
+	resourceName := "oraclepaas_database_snapshot.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseSnapshotExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "name", fmt.Sprintf("testsnapshot%d", ri)),
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "test snapshot"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "creation_time"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseSnapshotExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).databasePSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_database_snapshot" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		snapshot, err := client.getDatabaseSnapshot(serviceInstanceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Database Snapshot %q for %q: %+v", rs.Primary.ID, serviceInstanceID, err)
+		}
+		if snapshot == nil {
+			return fmt.Errorf("Database Snapshot %q for %q does not exist", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseSnapshotBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_database_snapshot" "test" {
+    service_instance_id = "${oraclepaas_database_service_instance.test.name}"
+    name                = "testsnapshot%d"
+    description         = "test snapshot"
+}`, testAccDatabaseServiceInstanceBasic(rInt), rInt)
+}
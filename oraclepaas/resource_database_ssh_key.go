@@ -0,0 +1,160 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	opcClient "github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The SSH Key API only allows a key to be set (which replaces the current key) and read.
+// There is no way to remove the key from a service instance, so deleting the resource
+// only removes it from state.
+func resourceOraclePAASDatabaseSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASDatabaseSSHKeyCreate,
+		Read:   resourceOraclePAASDatabaseSSHKeyRead,
+		Update: resourceOraclePAASDatabaseSSHKeyUpdate,
+		Delete: resourceOraclePAASDatabaseSSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_key_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"os_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASDatabaseSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Creating database ssh key")
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+	if err := setDatabaseSSHKey(meta, serviceInstanceID, d.Get("public_key").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(serviceInstanceID)
+
+	return resourceOraclePAASDatabaseSSHKeyRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.SSHKeys()
+
+	log.Printf("[DEBUG] Reading state of ssh key for database service instance %q", d.Id())
+	input := database.GetSSHKeyInput{
+		ServiceInstanceID: d.Id(),
+	}
+
+	result, err := client.GetSSHKey(&input)
+	if err != nil {
+		// The service instance, and therefore the SSH Key, does not exist
+		if opcClient.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading ssh key for database service instance %q: %+v", d.Id(), err)
+	}
+
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read state of ssh key for database service instance %q: %#v", d.Id(), result)
+	d.Set("service_instance_id", d.Id())
+	d.Set("public_key", result.PublicKey)
+	d.Set("compute_key_name", result.ComputeKeyName)
+	d.Set("last_update_message", result.LastUpdateMessage)
+	d.Set("last_update_status", result.LastUpdateStatus)
+	d.Set("last_update_time", result.LastUpdateTime)
+	d.Set("os_user_name", result.OsUserName)
+
+	return nil
+}
+
+func resourceOraclePAASDatabaseSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Updating database ssh key")
+
+	if d.HasChange("public_key") {
+		if err := setDatabaseSSHKey(meta, d.Id(), d.Get("public_key").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceOraclePAASDatabaseSSHKeyRead(d, meta)
+}
+
+func resourceOraclePAASDatabaseSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing ssh key for database service instance %q from state. The SSH Key API does not support deletion.", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// setDatabaseSSHKey replaces the SSH key of a database service instance and waits for the
+// change to be applied. It's shared with the service instance so the key can be rotated in place.
+func setDatabaseSSHKey(meta interface{}, serviceInstanceID, publicKey string, timeout time.Duration) error {
+	dbClient, err := getDatabaseClient(meta)
+	if err != nil {
+		return err
+	}
+	client := dbClient.SSHKeys()
+
+	input := database.CreateSSHKeyInput{
+		ServiceInstanceID: serviceInstanceID,
+		PublicKey:         publicKey,
+		Timeout:           timeout,
+	}
+
+	if _, err := client.CreateSSHKey(&input); err != nil {
+		return fmt.Errorf("Error setting SSH Key for Database Service Instance %q: %+v", serviceInstanceID, err)
+	}
+
+	return nil
+}
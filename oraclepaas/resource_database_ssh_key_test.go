@@ -0,0 +1,91 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-oracle-terraform/database"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDatabaseSSHKeyRotated = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC/bzBtJt4ZmH2YHAxvR3isn+F/10x62ciyjix4ssXWsj6xq7xA2wJLmskKZmFnHOR4PJr/KmrYsHgulV3F5CFNfDsgLtW7F1Lx9aR5QSgAG5iKaPyBngJEmJoo1pFJBUw4hMOuL78hR6S4+RvWS1a+rc/Yjv0vBP/VAAolX9KdLnk3MWvku/v1/p4WO/021r/o/YZrBerMXc7BFMTDzjTsTNLnmBqygRgnMW0MtZ0JTQDYVTVvoRu+UNeV4BRHZWc9i089wzw8LfDXevk02V3INcOBcWXzMb9fTHpTF/ZWxZhDcs+7meK58AQPkqJtkuqbPerj4Dhui3FqbqprhmX5"
+
+func TestAccOPAASDatabaseSSHKey_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDatabaseSSHKeyBasic(ri)
+	resourceName := "oraclepaas_database_ssh_key.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatabaseServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseSSHKeyExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "public_key", testAccDatabaseSSHKeyRotated),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "last_update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseSSHKeyExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).databaseClient.SSHKeys()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_database_ssh_key" {
+			continue
+		}
+
+		input := database.GetSSHKeyInput{
+			ServiceInstanceID: rs.Primary.Attributes["service_instance_id"],
+		}
+		if _, err := client.GetSSHKey(&input); err != nil {
+			return fmt.Errorf("Error retrieving state of Database SSH Key for %q: %+v", input.ServiceInstanceID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseSSHKeyBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_database_service_instance" "test" {
+    name        = "test-service-instance-%d"
+    description = "test service instance"
+    edition = "EE"
+    level = "PAAS"
+    shape = "oc3"
+    subscription_type = "HOURLY"
+    version = "12.2.0.1"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+
+    database_configuration {
+        admin_password = "Test_String7"
+        backup_destination = "NONE"
+        sid = "ORCL"
+        usable_storage = 15
+    }
+
+    lifecycle {
+        ignore_changes = ["ssh_public_key"]
+    }
+}
+
+resource "oraclepaas_database_ssh_key" "test" {
+    service_instance_id = "${oraclepaas_database_service_instance.test.name}"
+    public_key = "%s"
+}
+`, rInt, testAccDatabaseSSHKeyRotated)
+}
@@ -0,0 +1,84 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASJavaBackup_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccJavaBackupBasic(ri)
+	resourceName := "oraclepaas_java_backup.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJavaBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaBackupExists,
+					resource.TestCheckResourceAttrSet(
+						resourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJavaBackupExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).javaPSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_java_backup" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		backup, err := client.getBackup(serviceInstanceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Java Backup %q for %q: %+v", rs.Primary.ID, serviceInstanceID, err)
+		}
+		if backup == nil {
+			return fmt.Errorf("Java Backup %q for %q does not exist", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckJavaBackupDestroy(s *terraform.State) error {
+	if err := testAccCheckJavaServiceInstanceDestroy(s); err != nil {
+		return err
+	}
+
+	client := testAccProvider.Meta().(*OPAASClient).javaPSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_java_backup" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		if backup, err := client.getBackup(serviceInstanceID, rs.Primary.ID); err == nil && backup != nil {
+			return fmt.Errorf("Java Backup %q for %q still exists", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccJavaBackupBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_java_backup" "test" {
+    service_instance_id = "${oraclepaas_java_service_instance.test.name}"
+    note = "acceptance test backup"
+}`, testAccJavaServiceInstanceBasic(rInt))
+}
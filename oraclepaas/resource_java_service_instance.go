@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -102,6 +103,45 @@ func resourceOraclePAASJavaServiceInstance() *schema.Resource {
 					},
 				},
 			},
+			"backup_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"full_backup_day": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"MONDAY",
+								"TUESDAY",
+								"WEDNESDAY",
+								"THURSDAY",
+								"FRIDAY",
+								"SATURDAY",
+								"SUNDAY",
+							}, false),
+						},
+						"full_backup_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"incremental_backup_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"retention_days": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"metering_frequency": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -656,6 +696,14 @@ func resourceOraclePAASJavaServiceInstance() *schema.Resource {
 				Default:  true,
 				ForceNew: true,
 			},
+			"patch_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"release_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"desired_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -711,6 +759,14 @@ func resourceOraclePAASJavaServiceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -786,7 +842,7 @@ func resourceOraclePAASJavaServiceInstanceCreate(d *schema.ResourceData, meta in
 
 	info, err := client.CreateServiceInstance(&input)
 	if err != nil {
-		return fmt.Errorf("Error creating JavaServiceInstance: %s", err)
+		return lastJobError(meta, psmServiceTypeJava, input.ServiceName, fmt.Errorf("Error creating JavaServiceInstance: %s", err))
 	}
 
 	d.SetId(info.ServiceName)
@@ -829,6 +885,10 @@ func resourceOraclePAASJavaServiceInstanceRead(d *schema.ResourceData, meta inte
 	d.Set("metering_frequency", result.MeteringFrequency)
 	d.Set("force_delete", d.Get("force_delete"))
 	d.Set("desired_state", d.Get("desired_state"))
+	d.Set("release_version", result.ReleaseVersion)
+	if err := readJavaPatchLevel(d, meta); err != nil {
+		log.Printf("[WARN] Unable to read the applied patches of JavaServiceInstance %s: %+v", d.Id(), err)
+	}
 	d.Set("status", result.State)
 
 	if val, ok := d.GetOk("assign_public_ip"); ok {
@@ -855,6 +915,22 @@ func resourceOraclePAASJavaServiceInstanceRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("[DEBUG] Error setting Java Service Instance Oracle Traffic Director: %+v", err)
 	}
 
+	// There's no backup configuration to read when backups are disabled
+	if d.Get("backup_destination").(string) != string(java.ServiceInstanceBackupDestinationNone) {
+		backupClient, err := getPSMClient(meta, psmServiceTypeJava)
+		if err != nil {
+			return err
+		}
+		backupConfig, err := backupClient.getJavaBackupConfig(d.Id())
+		if err != nil {
+			log.Printf("[WARN] Unable to read the backup configuration of JavaServiceInstance %s: %+v", d.Id(), err)
+		} else if err := d.Set("backup_configuration", flattenJavaBackupConfig(backupConfig)); err != nil {
+			return fmt.Errorf("Error setting Java Service Instance Backup Configuration: %+v", err)
+		}
+	}
+
+	setLastJob(d, meta, psmServiceTypeJava)
+
 	return nil
 }
 
@@ -918,6 +994,32 @@ func resourceOraclePAASJavaServiceInstanceUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("patch_level") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		if err := updateJavaPatchLevel(d, meta, timeout); err != nil {
+			// The patch hasn't been applied, so keep the previous patch level in the state
+			oldPatchLevel, _ := d.GetChange("patch_level")
+			d.Set("patch_level", oldPatchLevel)
+			return err
+		}
+	}
+
+	// The backup configuration is applied here at create as well, as Create finishes by calling Update
+	if d.HasChange("backup_configuration") || d.IsNewResource() {
+		if _, ok := d.GetOk("backup_configuration"); ok {
+			backupClient, err := getPSMClient(meta, psmServiceTypeJava)
+			if err != nil {
+				return err
+			}
+			if err := backupClient.updateJavaBackupConfig(d.Id(), expandJavaBackupConfig(d)); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Updating the shape refers to changing the shape of the admin cluster for the weblogic server
 	if old, new := d.GetChange("weblogic_server.0.shape"); old.(string) != "" && old.(string) != new.(string) {
 		wlsComponent := java.ScaleUpDownWLS{
@@ -1349,6 +1451,96 @@ func expandJavaCloudStorage(d *schema.ResourceData, input *java.CreateServiceIns
 	}
 }
 
+// updateJavaPatchLevel applies the patch matching the configured patch level, which is either the id
+// or the release version of one of the available patches
+func updateJavaPatchLevel(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	jClient, err := getJavaClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	patchLevel := d.Get("patch_level").(string)
+	// The patch has been removed from the configuration, or the service instance is already at the patch level
+	if patchLevel == "" || patchLevel == d.Get("release_version").(string) {
+		return nil
+	}
+
+	patches, err := client.getJavaAvailablePatches(d.Id())
+	if err != nil {
+		return fmt.Errorf("Unable to read available patches for Java Service Instance %q: %+v", d.Id(), err)
+	}
+
+	patchID := ""
+	for _, patch := range patches {
+		if patch.PatchID == patchLevel || patch.ReleaseVersion == patchLevel {
+			patchID = patch.PatchID
+		}
+	}
+	if patchID == "" {
+		return fmt.Errorf("No patch matching %q is available for Java Service Instance %q", patchLevel, d.Id())
+	}
+
+	if err := client.applyJavaPatch(jClient, d.Id(), patchID, timeout); err != nil {
+		return lastJobError(meta, psmServiceTypeJava, d.Id(), fmt.Errorf("Unable to patch Java Service Instance %q: %+v", d.Id(), err))
+	}
+
+	return nil
+}
+
+// readJavaPatchLevel keeps the patch level in the state only while the service instance is at its release
+// version or the patch is applied, so that a patch that has failed or been rolled back shows up as a change
+func readJavaPatchLevel(d *schema.ResourceData, meta interface{}) error {
+	patchLevel := d.Get("patch_level").(string)
+	if patchLevel == "" || patchLevel == d.Get("release_version").(string) {
+		return nil
+	}
+
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	patches, err := client.getJavaAppliedPatches(d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, patch := range patches {
+		if patch.PatchID == patchLevel || patch.ReleaseVersion == patchLevel {
+			return nil
+		}
+	}
+
+	d.Set("patch_level", "")
+	return nil
+}
+
+func expandJavaBackupConfig(d *schema.ResourceData) *javaBackupConfig {
+	attrs := d.Get("backup_configuration").([]interface{})[0].(map[string]interface{})
+
+	backupConfig := &javaBackupConfig{
+		FullBackupSchedule: &javaBackupSchedule{
+			DayOfWeek: attrs["full_backup_day"].(string),
+			HourOfDay: strconv.Itoa(attrs["full_backup_hour"].(int)),
+		},
+	}
+	// Midnight is a valid hour, so check whether the incremental backup hour is set rather than non-zero
+	if v, ok := d.GetOkExists("backup_configuration.0.incremental_backup_hour"); ok {
+		backupConfig.IncrementalBackupSchedule = &javaBackupSchedule{
+			HourOfDay: strconv.Itoa(v.(int)),
+		}
+	}
+	if v, ok := attrs["retention_days"]; ok && v.(int) != 0 {
+		backupConfig.BackupRetentionDays = strconv.Itoa(v.(int))
+	}
+
+	return backupConfig
+}
+
 func expandAppDBs(webLogicServer *java.CreateWLS, config map[string]interface{}) {
 	appDBInfo := config["application_database"].(*schema.Set)
 	appDBs := make([]java.AppDB, appDBInfo.Len())
@@ -1780,3 +1972,21 @@ func flattenListener(d *schema.ResourceData) []interface{} {
 
 	return []interface{}{listenerConfig[0].(map[string]interface{})}
 }
+
+func flattenJavaBackupConfig(backupConfig *javaBackupConfig) []interface{} {
+	// Scheduled backups haven't been configured
+	if backupConfig.FullBackupSchedule == nil || backupConfig.FullBackupSchedule.DayOfWeek == "" {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"full_backup_day":  backupConfig.FullBackupSchedule.DayOfWeek,
+		"full_backup_hour": atoiOrZero(backupConfig.FullBackupSchedule.HourOfDay),
+		"retention_days":   atoiOrZero(backupConfig.BackupRetentionDays),
+	}
+	if backupConfig.IncrementalBackupSchedule != nil {
+		result["incremental_backup_hour"] = atoiOrZero(backupConfig.IncrementalBackupSchedule.HourOfDay)
+	}
+
+	return []interface{}{result}
+}
@@ -3,11 +3,13 @@ package oraclepaas
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/go-oracle-terraform/java"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -258,6 +260,65 @@ func TestAccOraclePAASJavaServiceInstance_LoadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccOraclePAASJavaServiceInstance_BackupConfiguration(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "oraclepaas_java_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJavaServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJavaServiceInstanceBackupConfiguration(ri, "SUNDAY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "backup_configuration.0.full_backup_day", "SUNDAY"),
+					resource.TestCheckResourceAttr(
+						resourceName, "backup_configuration.0.full_backup_hour", "2"),
+				),
+			},
+			{
+				Config: testAccJavaServiceInstanceBackupConfiguration(ri, "SATURDAY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "backup_configuration.0.full_backup_day", "SATURDAY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOraclePAASJavaServiceInstance_PatchLevel(t *testing.T) {
+	patchLevel := os.Getenv("TEST_JAVA_PATCH_LEVEL")
+	if patchLevel == "" {
+		t.Skip("Missing Environment Parameter `TEST_JAVA_PATCH_LEVEL`. You will need to set it to a patch available for 12cRelease212 to run this test.")
+	}
+
+	ri := acctest.RandInt()
+	resourceName := "oraclepaas_java_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJavaServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJavaServiceInstanceBasic(ri),
+				Check:  testAccCheckJavaServiceInstanceExists,
+			},
+			{
+				Config: testAccJavaServiceInstancePatchLevel(ri, patchLevel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaServiceInstanceExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "patch_level", patchLevel),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJavaServiceInstanceExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*OPAASClient).javaClient.ServiceInstanceClient()
 
@@ -355,6 +416,125 @@ resource "oraclepaas_java_service_instance" "test" {
 }`, rInt, os.Getenv("OPC_STORAGE_URL"), rInt, rInt, os.Getenv("OPC_STORAGE_URL"), rInt)
 }
 
+func testAccJavaServiceInstancePatchLevel(rInt int, patchLevel string) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_database_service_instance" "test" {
+    #bring_your_own_license = true
+
+    name        = "test-service-instance-%d"
+    description = "test service instance"
+    edition = "EE"
+    level = "PAAS"
+    shape = "oc3"
+    subscription_type = "HOURLY"
+    version = "12.2.0.1"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+
+    database_configuration {
+        admin_password = "Test_String7"
+        backup_destination = "OSS"
+        failover_database = false
+        sid = "ORCL"
+        usable_storage = 15
+    }
+
+    backups {
+        cloud_storage_container = "%sacctest-%d"
+        create_if_missing = true
+    }
+}
+
+resource "oraclepaas_java_service_instance" "test" {
+    #bring_your_own_license = true
+
+    name = "tfinstance%d"
+    edition = "SUITE"
+    service_version = "12cRelease212"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+    force_delete = true	
+    patch_level = "%s"
+
+    weblogic_server {
+        shape = "oc3"
+        database {
+            name = "${oraclepaas_database_service_instance.test.name}"
+			username = "sys"
+            password = "Test_String7"
+        }
+        admin {
+            username = "terraform-user"
+            password = "Test_String7"
+        }
+    }
+    backups {
+        cloud_storage_container = "%sacctest-%d"
+        auto_generate = true
+    }
+}`, rInt, os.Getenv("OPC_STORAGE_URL"), rInt, rInt, patchLevel, os.Getenv("OPC_STORAGE_URL"), rInt)
+}
+
+func testAccJavaServiceInstanceBackupConfiguration(rInt int, fullBackupDay string) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_database_service_instance" "test" {
+    #bring_your_own_license = true
+
+    name        = "test-service-instance-%d"
+    description = "test service instance"
+    edition = "EE"
+    level = "PAAS"
+    shape = "oc3"
+    subscription_type = "HOURLY"
+    version = "12.2.0.1"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+
+    database_configuration {
+        admin_password = "Test_String7"
+        backup_destination = "OSS"
+        failover_database = false
+        sid = "ORCL"
+        usable_storage = 15
+    }
+
+    backups {
+        cloud_storage_container = "%sacctest-%d"
+        create_if_missing = true
+    }
+}
+
+resource "oraclepaas_java_service_instance" "test" {
+    #bring_your_own_license = true
+
+    name = "tfinstance%d"
+    edition = "SUITE"
+    service_version = "12cRelease212"
+    ssh_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC3QxPp0BFK+ligB9m1FBcFELyvN5EdNUoSwTCe4Zv2b51OIO6wGM/dvTr/yj2ltNA/Vzl9tqf9AUBL8tKjAOk8uukip6G7rfigby+MvoJ9A8N0AC2te3TI+XCfB5Ty2M2OmKJjPOPCd6+OdzhT4cWnPOM+OAiX0DP7WCkO4Kx2kntf8YeTEurTCspOrRjGdo+zZkJxEydMt31asu9zYOTLmZPwLCkhel8vY6SnZhDTNSNkRzxZFv+Mh2VGmqu4SSxfVXr4tcFM6/MbAXlkA8jo+vHpy5sC79T4uNaPu2D8Ed7uC3yDdO3KRVdzZCfWHj4NjixdMs2CtK6EmyeVOPuiYb8/mcTybrb4F/CqA4jydAU6Ok0j0bIqftLyxNgfS31hR1Y3/GNPzly4+uUIgZqmsuVFh5h0L7qc1jMv7wRHphogo5snIp45t9jWNj8uDGzQgWvgbFP5wR7Nt6eS0kaCeGQbxWBDYfjQE801IrwhgMfmdmGw7FFveCH0tFcPm6td/8kMSyg/OewczZN3T62ETQYVsExOxEQl2t4SZ/yqklg+D9oGM+ILTmBRzIQ2m/xMmsbowiTXymjgVmvrWuc638X6dU2fKJ7As4hxs3rA1BA5sOt0XyqfHQhtYrL/Ovb1iV+C7MRhKicTyoNTc7oVcDDG0VW785d8CPqttDi50w=="
+    force_delete = true	
+
+    backup_configuration {
+        full_backup_day = "%s"
+        full_backup_hour = 2
+        incremental_backup_hour = 3
+    }
+
+    weblogic_server {
+        shape = "oc3"
+        database {
+            name = "${oraclepaas_database_service_instance.test.name}"
+			username = "sys"
+            password = "Test_String7"
+        }
+        admin {
+            username = "terraform-user"
+            password = "Test_String7"
+        }
+    }
+    backups {
+        cloud_storage_container = "%sacctest-%d"
+        auto_generate = true
+    }
+}`, rInt, os.Getenv("OPC_STORAGE_URL"), rInt, rInt, fullBackupDay, os.Getenv("OPC_STORAGE_URL"), rInt)
+}
+
 func testAccJavaServiceInstanceStop(rInt int) string {
 	return fmt.Sprintf(`
 resource "oraclepaas_database_service_instance" "test" {
@@ -911,3 +1091,25 @@ resource "oraclepaas_java_service_instance" "test" {
     }
 }`, rInt, os.Getenv("OPC_STORAGE_URL"), rInt, rInt, os.Getenv("OPC_STORAGE_URL"), rInt)
 }
+
+func TestExpandJavaBackupConfig_IncrementalBackupHour(t *testing.T) {
+	cases := []struct {
+		config   map[string]interface{}
+		expected *javaBackupSchedule
+	}{
+		{map[string]interface{}{"full_backup_day": "SUNDAY", "full_backup_hour": 1}, nil},
+		{map[string]interface{}{"full_backup_day": "SUNDAY", "full_backup_hour": 1, "incremental_backup_hour": 0}, &javaBackupSchedule{HourOfDay: "0"}},
+		{map[string]interface{}{"full_backup_day": "SUNDAY", "full_backup_hour": 1, "incremental_backup_hour": 22}, &javaBackupSchedule{HourOfDay: "22"}},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceOraclePAASJavaServiceInstance().Schema, map[string]interface{}{
+			"backup_configuration": []interface{}{tc.config},
+		})
+
+		backupConfig := expandJavaBackupConfig(d)
+		if !reflect.DeepEqual(backupConfig.IncrementalBackupSchedule, tc.expected) {
+			t.Fatalf("expected incremental backup schedule %#v for %v, got %#v", tc.expected, tc.config, backupConfig.IncrementalBackupSchedule)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/java"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOraclePAASJavaSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASJavaSnapshotCreate,
+		Read:   resourceOraclePAASJavaSnapshotRead,
+		Delete: resourceOraclePAASJavaSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cloned_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASJavaSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Print("[DEBUG] Creating java snapshot")
+
+	jClient, err := getJavaClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	input := &createJavaSnapshotInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	jobID, err := client.createJavaSnapshot(serviceInstanceID, input)
+	if err != nil {
+		return err
+	}
+	d.SetId(input.Name)
+	d.Set("job_id", jobID)
+
+	getJobInput := &java.GetJobInput{
+		ID: jobID,
+	}
+	if err := jClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return lastJobError(meta, psmServiceTypeJava, serviceInstanceID, fmt.Errorf("Error creating snapshot %q of Java Service Instance %q: %+v", input.Name, serviceInstanceID, err))
+	}
+
+	return resourceOraclePAASJavaSnapshotRead(d, meta)
+}
+
+func resourceOraclePAASJavaSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Reading state of snapshot %q for java service instance %q", d.Id(), serviceInstanceID)
+	result, err := client.getJavaSnapshot(serviceInstanceID, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading snapshot %q of Java Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	// The snapshot, or the service instance, does not exist
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	clonedServices := make([]string, 0, len(result.Clones))
+	for _, clone := range result.Clones {
+		clonedServices = append(clonedServices, clone.ServiceName)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("description", result.Description)
+	if err := d.Set("cloned_services", clonedServices); err != nil {
+		return err
+	}
+	d.Set("creation_date", result.CreationDate)
+	d.Set("status", result.Status)
+
+	return nil
+}
+
+func resourceOraclePAASJavaSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	jClient, err := getJavaClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeJava)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Deleting snapshot %q of java service instance %q", d.Id(), serviceInstanceID)
+	jobID, err := client.deleteJavaSnapshot(serviceInstanceID, d.Id())
+	if err != nil {
+		return err
+	}
+	// The snapshot, or the service instance, no longer exists
+	if jobID == "" {
+		return nil
+	}
+
+	getJobInput := &java.GetJobInput{
+		ID: jobID,
+	}
+	if err := jClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error deleting snapshot %q of Java Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASJavaSnapshot_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccJavaSnapshotBasic(ri)
+	resourceName := "oraclepaas_java_snapshot.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJavaServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJavaSnapshotExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "name", fmt.Sprintf("testsnapshot%d", ri)),
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "test snapshot"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJavaSnapshotExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).javaPSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_java_snapshot" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		snapshot, err := client.getJavaSnapshot(serviceInstanceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Java Snapshot %q for %q: %+v", rs.Primary.ID, serviceInstanceID, err)
+		}
+		if snapshot == nil {
+			return fmt.Errorf("Java Snapshot %q for %q does not exist", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccJavaSnapshotBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_java_snapshot" "test" {
+    service_instance_id = "${oraclepaas_java_service_instance.test.name}"
+    name                = "testsnapshot%d"
+    description         = "test snapshot"
+}`, testAccJavaServiceInstanceBasic(rInt), rInt)
+}
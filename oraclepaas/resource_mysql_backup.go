@@ -0,0 +1,158 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOraclePAASMySQLBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASMySQLBackupCreate,
+		Read:   resourceOraclePAASMySQLBackupRead,
+		Delete: resourceOraclePAASMySQLBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"full_backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"note": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_completed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASMySQLBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Print("[DEBUG] Creating mysql backup")
+
+	mysqlClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+	input := &startBackupInput{
+		FullBackup: d.Get("full_backup").(bool),
+		Note:       d.Get("note").(string),
+	}
+
+	jobID, backup, err := client.createBackup(serviceInstanceID, input, waitMySQLBackupJob(mysqlClient), d.Timeout(schema.TimeoutCreate))
+	d.Set("job_id", jobID)
+	if err != nil {
+		return lastJobError(meta, psmServiceTypeMySQL, serviceInstanceID, fmt.Errorf("Error backing up MySQL Service Instance %q: %+v", serviceInstanceID, err))
+	}
+
+	d.SetId(backup.BackupID)
+	return resourceOraclePAASMySQLBackupRead(d, meta)
+}
+
+func resourceOraclePAASMySQLBackupRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Reading state of backup %q for mysql service instance %q", d.Id(), serviceInstanceID)
+	result, err := client.getBackup(serviceInstanceID, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading backup %q of MySQL Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	// The service instance or the backup has expired or been deleted
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backup_id", result.BackupID)
+	d.Set("date_completed", result.DateCompleted)
+	d.Set("date_started", result.DateStarted)
+	d.Set("expiration_date", result.ExpirationDate)
+	d.Set("status", result.Status)
+	d.Set("type", result.BackupType)
+
+	return nil
+}
+
+func resourceOraclePAASMySQLBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	mysqlClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceID := d.Get("service_instance_id").(string)
+
+	log.Printf("[DEBUG] Deleting backup %q of mysql service instance %q", d.Id(), serviceInstanceID)
+	if err := client.removeBackup(serviceInstanceID, d.Id(), waitMySQLBackupJob(mysqlClient), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error deleting backup %q of MySQL Service Instance %q: %+v", d.Id(), serviceInstanceID, err)
+	}
+
+	return nil
+}
+
+// waitMySQLBackupJob waits for a backup job of a mysql service instance to complete
+func waitMySQLBackupJob(mysqlClient *mysql.MySQLClient) psmJobWaiter {
+	return func(jobID string, timeout time.Duration) error {
+		getJobInput := &mysql.GetJobInput{
+			ID: jobID,
+		}
+		return mysqlClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, timeout)
+	}
+}
@@ -0,0 +1,84 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASMySQLBackup_Basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccMySQLBackupBasic(ri)
+	resourceName := "oraclepaas_mysql_backup.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMySQLBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLBackupExists,
+					resource.TestCheckResourceAttrSet(
+						resourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMySQLBackupExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).mysqlPSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_mysql_backup" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		backup, err := client.getBackup(serviceInstanceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of MySQL Backup %q for %q: %+v", rs.Primary.ID, serviceInstanceID, err)
+		}
+		if backup == nil {
+			return fmt.Errorf("MySQL Backup %q for %q does not exist", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMySQLBackupDestroy(s *terraform.State) error {
+	if err := testAccCheckMySQLServiceInstanceDestroy(s); err != nil {
+		return err
+	}
+
+	client := testAccProvider.Meta().(*OPAASClient).mysqlPSMClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_mysql_backup" {
+			continue
+		}
+
+		serviceInstanceID := rs.Primary.Attributes["service_instance_id"]
+		if backup, err := client.getBackup(serviceInstanceID, rs.Primary.ID); err == nil && backup != nil {
+			return fmt.Errorf("MySQL Backup %q for %q still exists", rs.Primary.ID, serviceInstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMySQLBackupBasic(rInt int) string {
+	return fmt.Sprintf(`%s
+
+resource "oraclepaas_mysql_backup" "test" {
+    service_instance_id = "${oraclepaas_mysql_service_instance.test.name}"
+    note = "acceptance test backup"
+}`, testAccMySQLServiceInstanceCloudStorage(rInt))
+}
@@ -0,0 +1,163 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-oracle-terraform/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOraclePAASMySQLIPReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOraclePAASMySQLIPReservationCreate,
+		Read:   resourceOraclePAASMySQLIPReservationRead,
+		Delete: resourceOraclePAASMySQLIPReservationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"compute_site_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOraclePAASMySQLIPReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Creating mysql ip reservation")
+
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.IPReservationClient()
+	client.Timeout = d.Timeout(schema.TimeoutCreate)
+
+	input := mysql.CreateIPReservationInput{
+		Name:   d.Get("name").(string),
+		Region: d.Get("region").(string),
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = v.(string)
+	}
+
+	info, err := client.CreateIPReservation(&input)
+	if err != nil {
+		return fmt.Errorf("Error creating MySQL IP Reservation: %+v", err)
+	}
+
+	d.SetId(info.Name)
+
+	return resourceOraclePAASMySQLIPReservationRead(d, meta)
+}
+
+func resourceOraclePAASMySQLIPReservationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.IPReservationClient()
+
+	log.Printf("[DEBUG] Reading state of mysql ip reservation %q", d.Id())
+	result, err := client.GetIPReservation(d.Id())
+	if err != nil {
+		// IPReservation does not exist
+		if wasIPReservationNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading mysql ip reservation %q: %+v", d.Id(), err)
+	}
+
+	if result == nil {
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read state of mysql ip reservation %q: %#v", d.Id(), result)
+	d.Set("name", result.Name)
+	d.Set("network_type", result.NetworkType)
+	d.Set("compute_site_name", result.ComputeSiteName)
+	d.Set("hostname", result.Hostname)
+	d.Set("identity_domain", result.IdentityDomain)
+	d.Set("ip_address", result.IPAddress)
+	d.Set("service_name", result.ServiceName)
+	d.Set("status", result.Status)
+
+	// The API doesn't return the region, only the compute site the reservation lives in,
+	// so we only fall back to that when the region isn't already known (e.g. on import)
+	if _, ok := d.GetOk("region"); !ok {
+		d.Set("region", result.ComputeSiteName)
+	}
+
+	return nil
+}
+
+func resourceOraclePAASMySQLIPReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Resource state: %#v", d.State())
+
+	log.Print("[DEBUG] Deleting mysql ip reservation")
+
+	mySQLClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client := mySQLClient.IPReservationClient()
+	client.Timeout = d.Timeout(schema.TimeoutDelete)
+	client.PollInterval = ipReservationDeletePollInterval
+
+	if err := client.DeleteIPReservation(d.Id()); err != nil {
+		return fmt.Errorf("Error deleting MySQL IP Reservation %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
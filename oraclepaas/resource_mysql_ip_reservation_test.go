@@ -0,0 +1,93 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOPAASMySQLIPReservation_Basic(t *testing.T) {
+	region := os.Getenv("TEST_OCI_CLASSIC_REGION")
+	if region == "" {
+		t.Skip("Missing Environment Parameter `TEST_OCI_CLASSIC_REGION`. You will need to set it to an OCI Classic region to run this test.")
+	}
+
+	ri := acctest.RandInt()
+	config := testAccMySQLIPReservationBasic(ri, region)
+	resourceName := "oraclepaas_mysql_ip_reservation.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMySQLIPReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLIPReservationExists,
+					resource.TestCheckResourceAttr(
+						resourceName, "name", fmt.Sprintf("test-mysql-ip-reservation-%d", ri)),
+					resource.TestCheckResourceAttr(
+						resourceName, "region", region),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "ip_address"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API only returns the compute site, not the region the reservation was created in
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
+
+func testAccCheckMySQLIPReservationExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).mysqlClient.IPReservationClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_mysql_ip_reservation" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		if _, err := client.GetIPReservation(name); err != nil {
+			return fmt.Errorf("Error retrieving state of MySQL IP Reservation %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMySQLIPReservationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*OPAASClient).mysqlClient.IPReservationClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oraclepaas_mysql_ip_reservation" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		if info, err := client.GetIPReservation(name); err == nil && info != nil {
+			return fmt.Errorf("MySQL IP Reservation %q still exists: %#v", name, info)
+		}
+	}
+
+	return nil
+}
+
+func testAccMySQLIPReservationBasic(rInt int, region string) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_mysql_ip_reservation" "test" {
+    name   = "test-mysql-ip-reservation-%d"
+    region = "%s"
+}
+`, rInt, region)
+}
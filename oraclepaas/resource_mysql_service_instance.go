@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	opcClient "github.com/hashicorp/go-oracle-terraform/client"
@@ -16,13 +17,17 @@ func resourceOraclePAASMySQLServiceInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOraclePAASMySQLServiceInstanceCreate,
 		Read:   resourceOraclePAASMySQLServiceInstanceRead,
+		Update: resourceOraclePAASMySQLServiceInstanceUpdate,
 		Delete: resourceOraclePAASMySQLServiceInstanceDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		CustomizeDiff: resourceOraclePAASMySQLServiceInstanceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -109,7 +114,21 @@ func resourceOraclePAASMySQLServiceInstance() *schema.Resource {
 			"shape": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+
+			"restore_backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"desired_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysqlServiceInstanceLifecycleStateStop),
+					string(mysqlServiceInstanceLifecycleStateRestart),
+					string(mysqlServiceInstanceLifecycleStateStart),
+				}, true),
 			},
 
 			"backups": {
@@ -151,7 +170,6 @@ func resourceOraclePAASMySQLServiceInstance() *schema.Resource {
 			"mysql_configuration": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				MinItems: 1,
 				Elem: &schema.Resource{
@@ -165,7 +183,6 @@ func resourceOraclePAASMySQLServiceInstance() *schema.Resource {
 							// integer. default 25
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(25, 1024),
 							Default:      25,
 						},
@@ -298,6 +315,14 @@ func resourceOraclePAASMySQLServiceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		}, // end declaration
 	} // end return
 }
@@ -313,6 +338,11 @@ func resourceOraclePAASMySQLServiceInstanceCreate(d *schema.ResourceData, meta i
 	client := mySQLClient.ServiceInstanceClient()
 	client.Timeout = d.Timeout(schema.TimeoutCreate)
 
+	// A backup can only be restored to a service instance that already exists
+	if _, ok := d.GetOk("restore_backup_id"); ok {
+		return fmt.Errorf("[Error] : `restore_backup_id` can't be set when creating a MySQL Service Instance, set it once the service instance exists")
+	}
+
 	input := mysql.CreateServiceInstanceInput{}
 	input.ServiceParameters, err = expandServiceParameters(d)
 	if err != nil {
@@ -327,10 +357,18 @@ func resourceOraclePAASMySQLServiceInstanceCreate(d *schema.ResourceData, meta i
 	newServiceInstance, err := client.CreateServiceInstance(&input)
 
 	if err != nil {
-		return fmt.Errorf("[Error] : Error while creating MySQL Service Instance : %v", err)
+		return lastJobError(meta, psmServiceTypeMySQL, input.ServiceParameters.ServiceName, fmt.Errorf("[Error] : Error while creating MySQL Service Instance : %v", err))
 	}
 
 	d.SetId(newServiceInstance.ServiceName)
+
+	// The service instance is running once it's created, so only stopping it needs to be applied
+	if lifecycleState := mysqlServiceInstanceLifecycleState(strings.ToLower(d.Get("desired_state").(string))); lifecycleState == mysqlServiceInstanceLifecycleStateStop {
+		if err := updateMySQLServiceInstanceDesiredState(d.Id(), lifecycleState, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceOraclePAASMySQLServiceInstanceRead(d, meta)
 }
 
@@ -527,6 +565,8 @@ func resourceOraclePAASMySQLServiceInstanceRead(d *schema.ResourceData, meta int
 	d.Set("creator", result.Creator)
 	d.Set("creation_date", result.CreationDate)
 	d.Set("ssh_public_key", d.Get("ssh_public_key"))
+	d.Set("desired_state", d.Get("desired_state"))
+	d.Set("restore_backup_id", d.Get("restore_backup_id"))
 	if val, ok := d.GetOk("subnet"); ok {
 		d.Set("subnet", val)
 	}
@@ -535,6 +575,8 @@ func resourceOraclePAASMySQLServiceInstanceRead(d *schema.ResourceData, meta int
 		return err
 	}
 
+	setLastJob(d, meta, psmServiceTypeMySQL)
+
 	return nil
 }
 
@@ -595,6 +637,162 @@ func flattenMySQLAttributesFromAttachments(d *schema.ResourceData, instanceInfo
 	return d.Set("mysql_configuration", result)
 }
 
+// The db storage can only be increased in place, so a reduction is rejected when planning
+func resourceOraclePAASMySQLServiceInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if o, n := d.GetChange("mysql_configuration.0.db_storage"); n.(int) < o.(int) {
+		return fmt.Errorf("cannot reduce db storage from %dGB to %dGB for %q", o.(int), n.(int), d.Id())
+	}
+
+	return nil
+}
+
+func resourceOraclePAASMySQLServiceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	mysqlClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	// Reject a storage reduction before any of the jobs run, so the service instance isn't left half updated
+	if o, n := d.GetChange("mysql_configuration.0.db_storage"); n.(int) < o.(int) {
+		return fmt.Errorf("cannot reduce db storage from %dGB to %dGB for %q", o.(int), n.(int), d.Id())
+	}
+
+	// Each change runs a separate job, so only the changes that have been applied are saved if a job fails
+	d.Partial(true)
+
+	if old, new := d.GetChange("restore_backup_id"); new.(string) != "" && old.(string) != new.(string) {
+		if err := restoreMySQLServiceInstance(d.Id(), new.(string), meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	d.SetPartial("restore_backup_id")
+
+	// Removing the desired state leaves the service instance as it is
+	if d.HasChange("desired_state") && d.Get("desired_state").(string) != "" {
+		lifecycleState := mysqlServiceInstanceLifecycleState(strings.ToLower(d.Get("desired_state").(string)))
+		if err := updateMySQLServiceInstanceDesiredState(d.Id(), lifecycleState, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	d.SetPartial("desired_state")
+
+	if !d.HasChange("shape") && !d.HasChange("mysql_configuration.0.db_storage") {
+		d.Partial(false)
+		return resourceOraclePAASMySQLServiceInstanceRead(d, meta)
+	}
+
+	result, err := mysqlClient.ServiceInstanceClient().GetServiceInstance(&mysql.GetServiceInstanceInput{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading mysql service instance %s: %+v", d.Id(), err)
+	}
+
+	hosts := make([]string, 0, len(result.Components.Mysql.VMInstances))
+	for _, vmInstance := range result.Components.Mysql.VMInstances {
+		hosts = append(hosts, vmInstance.HostName)
+	}
+
+	if old, new := d.GetChange("shape"); old.(string) != "" && old.(string) != new.(string) {
+		jobID, err := client.scaleMySQLServiceInstance(d.Id(), hosts, new.(string))
+		if err != nil {
+			return err
+		}
+		getJobInput := &mysql.GetJobInput{
+			ID: jobID,
+		}
+		if err := mysqlClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return lastJobError(meta, psmServiceTypeMySQL, d.Id(), fmt.Errorf("Error scaling MySQL Service Instance %q to %s: %+v", d.Id(), new.(string), err))
+		}
+	}
+	d.SetPartial("shape")
+
+	if o, n := d.GetChange("mysql_configuration.0.db_storage"); o.(int) != n.(int) {
+		additionalStorage := n.(int) - o.(int)
+
+		jobID, err := client.addMySQLServiceInstanceStorage(d.Id(), hosts, additionalStorage)
+		if err != nil {
+			return err
+		}
+		getJobInput := &mysql.GetJobInput{
+			ID: jobID,
+		}
+		if err := mysqlClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return lastJobError(meta, psmServiceTypeMySQL, d.Id(), fmt.Errorf("Error adding storage to MySQL Service Instance %q: %+v", d.Id(), err))
+		}
+	}
+
+	d.Partial(false)
+	return resourceOraclePAASMySQLServiceInstanceRead(d, meta)
+}
+
+// updateMySQLServiceInstanceDesiredState stops, starts or restarts the service instance, then waits for
+// the service instance to be STOPPED or READY
+func updateMySQLServiceInstanceDesiredState(name string, lifecycleState mysqlServiceInstanceLifecycleState, meta interface{}, timeout time.Duration) error {
+	mysqlClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	jobID, err := client.updateMySQLDesiredState(name, lifecycleState)
+	if err != nil {
+		return err
+	}
+
+	getJobInput := &mysql.GetJobInput{
+		ID: jobID,
+	}
+	if err := mysqlClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, timeout); err != nil {
+		return lastJobError(meta, psmServiceTypeMySQL, name, fmt.Errorf("Error updating desired state of MySQL Service Instance %q to %s: %+v", name, lifecycleState, err))
+	}
+
+	desiredState := mysql.ServiceInstanceReady
+	if lifecycleState == mysqlServiceInstanceLifecycleStateStop {
+		desiredState = mysql.ServiceInstanceStopped
+	}
+
+	return client.waitForMySQLServiceInstanceState(name, desiredState, timeout)
+}
+
+// restoreMySQLServiceInstance restores the service instance from the backup, then waits for the
+// service instance to be ready
+func restoreMySQLServiceInstance(name, backupID string, meta interface{}, timeout time.Duration) error {
+	mysqlClient, err := getMySQLClient(meta)
+	if err != nil {
+		return err
+	}
+	client, err := getPSMClient(meta, psmServiceTypeMySQL)
+	if err != nil {
+		return err
+	}
+
+	jobID, err := client.restoreBackup(name, backupID)
+	if err != nil {
+		return err
+	}
+
+	getJobInput := &mysql.GetJobInput{
+		ID: jobID,
+	}
+	if err := mysqlClient.Jobs().WaitForJobCompletion(getJobInput, psmJobPollInterval, timeout); err != nil {
+		return lastJobError(meta, psmServiceTypeMySQL, name, fmt.Errorf("Error restoring MySQL Service Instance %q from backup %q: %+v", name, backupID, err))
+	}
+
+	return client.waitForMySQLServiceInstanceState(name, mysql.ServiceInstanceReady, timeout)
+}
+
 func resourceOraclePAASMySQLServiceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Print("[DEBUG] Deleting mySQL service instance")
@@ -59,6 +59,57 @@ func TestAccOPAASMySQLServiceInstance_CloudStorage(t *testing.T) {
 	})
 }
 
+func TestAccOPAASMySQLServiceInstance_UpdateShapeAndStorage(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "oraclepaas_mysql_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMySQLServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMySQLServiceInstanceShapeAndStorage(ri, "oc3", 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					resource.TestCheckResourceAttr(resourceName, "shape", "oc3"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_configuration.0.db_storage", "25")),
+			},
+			{
+				Config: testAccMySQLServiceInstanceShapeAndStorage(ri, "oc4", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					resource.TestCheckResourceAttr(resourceName, "shape", "oc4"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_configuration.0.db_storage", "50")),
+			},
+		},
+	})
+}
+
+func TestAccOPAASMySQLServiceInstance_DesiredState(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "oraclepaas_mysql_service_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMySQLServiceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMySQLServiceInstanceDesiredState(ri, "start"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "start")),
+			},
+			{
+				Config: testAccMySQLServiceInstanceDesiredState(ri, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMySQLServiceInstanceExists,
+					testAccCheckMySQLServiceInstanceState(resourceName, mysql.ServiceInstanceStopped),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "stop")),
+			},
+		},
+	})
+}
+
 /* Test with OCI.
  */
 func TestAccOPAASMySQLServiceInstance_OCI(t *testing.T) {
@@ -123,6 +174,29 @@ func testAccCheckMySQLServiceInstanceExists(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckMySQLServiceInstanceState(resourceName string, state mysql.ServiceInstanceState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("MySQL Service Instance %s not found", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*OPAASClient).mysqlClient.ServiceInstanceClient()
+		input := mysql.GetServiceInstanceInput{
+			Name: rs.Primary.Attributes["name"],
+		}
+
+		info, err := client.GetServiceInstance(&input)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of MySQLServiceInstance %s: %+v", input.Name, err)
+		}
+		if info.Status != state {
+			return fmt.Errorf("Expected MySQLServiceInstance %s to be %s, got %s", input.Name, state, info.Status)
+		}
+		return nil
+	}
+}
+
 func testAccCheckMySQLServiceInstanceDestroy(s *terraform.State) error {
 
 	client := testAccProvider.Meta().(*OPAASClient).mysqlClient.ServiceInstanceClient()
@@ -202,6 +276,49 @@ resource "oraclepaas_mysql_service_instance" "test" {
 }`, rInt, os.Getenv("OPC_STORAGE_URL"), rInt)
 }
 
+func testAccMySQLServiceInstanceShapeAndStorage(rInt int, shape string, dbStorage int) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_mysql_service_instance" "test" {
+	description        = "Test Service Instance Scaling"
+	name               = "TestInst%d"
+	ssh_public_key     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC0Pspsfu8lUTxILGf+dJnTTbIeFZrL/NKaQNNEvH9jF9aXcr347C5dKlu45LE2jTB8OfjtaExOznn7kKiOErwWPJUzDncDDsmUacDzs5KGbDBGQb6zxEMyYgYCKDiru5V24CrZqam+3QP5AurLopD3JaYmZSikKgP+syu16jBs3WzRLvGzDknIkrUk6t7XjzJ5X/wgMTqepjDDyn9NJ3nG5l4iQe7ULgAbfnRjTM3pRQZ5EM67iN3jc+cIFeNsEwqnxb9ZCJ7avb+Yqdcm/7A5tlX+rMwnTYYCPF/j8bgFdHuO9VHEiQHkM7FuRvZGWkXCryyg9iLM+myG5XdVa3Z2IsfBx3qIfxKMcWsHIk5mmDvWIDbgvBne6JSPKhkB7qM6F10pJSVvt08tGwmlTxZZJPKCkpd0nrfrVChMdMr9yRoYH46bqwMbPFCffNeVkJfj4IMlSSU+A9RGLLEnkdv+Xk3yCS+8RcNA6Zilv9VnJm4hBEJ2LsDVZfwqTvUAeB4evpOCMS+v4YKn/w+R4cB/+SdYDtifBwKW8TYk4ZK3J4wHa6XAI4u3b9C0bIfUmXZs36Gyy4MArtg6QGqrmTzYMa5eI2uB7BnO0JM/Moref8vvQYvGjbnkC5G/yCoLswbt477Gn+Ih96PyZ81qMmTv8qE9S3F3qCqkR3sDJA3oDw=="
+	backup_destination = "NONE"
+	shape              = "%s"
+
+	mysql_configuration {
+		db_name         = "demo_db"
+		db_storage      = %d
+		mysql_port      = 3306
+		mysql_username  = "root"
+		mysql_password  = "MySqlPassword_1"
+		mysql_charset   = "utf8"
+		mysql_collation = "utf8_general_ci"
+	}
+}`, rInt, shape, dbStorage)
+}
+
+func testAccMySQLServiceInstanceDesiredState(rInt int, desiredState string) string {
+	return fmt.Sprintf(`
+resource "oraclepaas_mysql_service_instance" "test" {
+	description        = "Test Service Instance Desired State"
+	name               = "TestInst%d"
+	ssh_public_key     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC0Pspsfu8lUTxILGf+dJnTTbIeFZrL/NKaQNNEvH9jF9aXcr347C5dKlu45LE2jTB8OfjtaExOznn7kKiOErwWPJUzDncDDsmUacDzs5KGbDBGQb6zxEMyYgYCKDiru5V24CrZqam+3QP5AurLopD3JaYmZSikKgP+syu16jBs3WzRLvGzDknIkrUk6t7XjzJ5X/wgMTqepjDDyn9NJ3nG5l4iQe7ULgAbfnRjTM3pRQZ5EM67iN3jc+cIFeNsEwqnxb9ZCJ7avb+Yqdcm/7A5tlX+rMwnTYYCPF/j8bgFdHuO9VHEiQHkM7FuRvZGWkXCryyg9iLM+myG5XdVa3Z2IsfBx3qIfxKMcWsHIk5mmDvWIDbgvBne6JSPKhkB7qM6F10pJSVvt08tGwmlTxZZJPKCkpd0nrfrVChMdMr9yRoYH46bqwMbPFCffNeVkJfj4IMlSSU+A9RGLLEnkdv+Xk3yCS+8RcNA6Zilv9VnJm4hBEJ2LsDVZfwqTvUAeB4evpOCMS+v4YKn/w+R4cB/+SdYDtifBwKW8TYk4ZK3J4wHa6XAI4u3b9C0bIfUmXZs36Gyy4MArtg6QGqrmTzYMa5eI2uB7BnO0JM/Moref8vvQYvGjbnkC5G/yCoLswbt477Gn+Ih96PyZ81qMmTv8qE9S3F3qCqkR3sDJA3oDw=="
+	backup_destination = "NONE"
+	shape              = "oc3"
+	desired_state      = "%s"
+
+	mysql_configuration {
+		db_name         = "demo_db"
+		db_storage      = 25
+		mysql_port      = 3306
+		mysql_username  = "root"
+		mysql_password  = "MySqlPassword_1"
+		mysql_charset   = "utf8"
+		mysql_collation = "utf8_general_ci"
+	}
+}`, rInt, desiredState)
+}
+
 func testAccMySQLServiceInstanceOCI(rInt int, oci_region string, oci_availability_domain string, oci_subnet string) string {
 
 	return fmt.Sprintf(`
@@ -227,3 +344,44 @@ resource "oraclepaas_mysql_service_instance" "test" {
 	}
 }`, rInt, oci_region, oci_availability_domain, oci_subnet)
 }
+
+func TestResourceOraclePAASMySQLServiceInstance_ReduceDBStorage(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"name":                             "test",
+			"ssh_public_key":                   "key",
+			"shape":                            "oc3",
+			"mysql_configuration.#":            "1",
+			"mysql_configuration.0.db_storage": "50",
+		},
+	}
+
+	cases := []struct {
+		dbStorage   int
+		expectError bool
+	}{
+		{25, true},
+		{50, false},
+		{100, false},
+	}
+
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":           "test",
+			"ssh_public_key": "key",
+			"shape":          "oc3",
+			"mysql_configuration": []interface{}{
+				map[string]interface{}{"db_storage": tc.dbStorage},
+			},
+		})
+
+		_, err := resourceOraclePAASMySQLServiceInstance().Diff(state, config, nil)
+		if tc.expectError && err == nil {
+			t.Fatalf("Expected reducing the db storage to %dGB to be rejected when planning", tc.dbStorage)
+		}
+		if !tc.expectError && err != nil {
+			t.Fatalf("Expected changing the db storage to %dGB to be planned, got: %+v", tc.dbStorage, err)
+		}
+	}
+}
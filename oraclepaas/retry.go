@@ -0,0 +1,223 @@
+package oraclepaas
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Classes of failed requests that can be retried
+const (
+	// Refused connections, and connection resets and timeouts of idempotent requests
+	retryClassConnection = "connection"
+	// 429 Too Many Requests responses
+	retryClassThrottling = "throttling"
+	// 5xx responses, except 501 Not Implemented
+	retryClassServerError = "server_error"
+	// Any other 4xx responses, e.g. validation errors, which are unlikely to succeed when retried
+	retryClassClientError = "client_error"
+)
+
+func retryClasses() []string {
+	return []string{retryClassConnection, retryClassThrottling, retryClassServerError, retryClassClientError}
+}
+
+// Retry classes used when none are configured
+var defaultRetryClasses = []string{retryClassConnection, retryClassThrottling, retryClassServerError}
+
+// Bounds of the exponential backoff between retries
+const (
+	retryMinBackoff = 1 * time.Second
+	retryMaxBackoff = 60 * time.Second
+)
+
+// retryPolicy decides which failed requests are retried, and for how long
+type retryPolicy struct {
+	// Maximum number of attempts of a request, including the first attempt
+	maxAttempts int
+	// Classes of failed requests that are retried
+	classes map[string]bool
+	// Maximum time spent retrying a request, no limit when zero
+	maxElapsedTime time.Duration
+}
+
+func newRetryPolicy(maxAttempts int, classes []string, maxElapsedTime time.Duration) *retryPolicy {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if len(classes) == 0 {
+		classes = defaultRetryClasses
+	}
+
+	policy := &retryPolicy{
+		maxAttempts:    maxAttempts,
+		classes:        make(map[string]bool, len(classes)),
+		maxElapsedTime: maxElapsedTime,
+	}
+	for _, class := range classes {
+		policy.classes[class] = true
+	}
+
+	return policy
+}
+
+// idempotentMethods are the HTTP methods that can be sent again without repeating their effect
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// classify returns the retry class of a failed request, or an empty string if the request succeeded
+// or failed in a way that can't be retried
+func classify(method string, resp *http.Response, err error) string {
+	if err != nil {
+		// A refused connection fails before the request is sent, so any request can be sent again
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return retryClassConnection
+		}
+		// Other connection failures may happen after the server has received the request, so sending a
+		// non-idempotent request again, e.g. a POST starting a job, could repeat it
+		var netErr net.Error
+		if idempotentMethods[method] && (errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+			(errors.As(err, &netErr) && netErr.Timeout())) {
+			return retryClassConnection
+		}
+		return ""
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return retryClassThrottling
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return retryClassServerError
+	case resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError:
+		return retryClassClientError
+	}
+
+	return ""
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the response, which is either
+// a number of seconds or a HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// backoff returns the delay before the given retry, growing exponentially with jitter
+func backoff(retry int) time.Duration {
+	delay := retryMinBackoff
+	for i := 1; i < retry && delay < retryMaxBackoff; i++ {
+		delay *= 2
+	}
+	delay += time.Duration(rand.Int63n(int64(delay))) / 2
+	if delay > retryMaxBackoff {
+		delay = retryMaxBackoff
+	}
+
+	return delay
+}
+
+// retryDelay returns the delay before retrying the failed attempt, which is the delay requested by the
+// Retry-After header, or an exponential backoff. Without a maximum elapsed time to bound the requested
+// delay, it's limited to the maximum backoff.
+func (p *retryPolicy) retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay, ok := retryAfter(resp)
+	if !ok {
+		return backoff(attempt)
+	}
+	if p.maxElapsedTime == 0 && delay > retryMaxBackoff {
+		delay = retryMaxBackoff
+	}
+
+	return delay
+}
+
+// retryTransport is a http.RoundTripper that retries failed requests according to the retry policy.
+// The SDK clients retry any failed request, so they're configured to make a single attempt and leave
+// the retries to the transport.
+type retryTransport struct {
+	base   http.RoundTripper
+	policy *retryPolicy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Cache the body so that it can be sent again with each attempt
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		if body != nil {
+			attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+
+		class := classify(req.Method, resp, err)
+		if class == "" || !t.policy.classes[class] || attempt >= t.policy.maxAttempts {
+			return resp, err
+		}
+
+		delay := t.policy.retryDelay(resp, attempt)
+
+		if t.policy.maxElapsedTime > 0 && time.Since(start)+delay > t.policy.maxElapsedTime {
+			log.Printf("[DEBUG] Not retrying %s %s, retrying in %s would exceed the maximum elapsed time of %s", req.Method, req.URL, delay, t.policy.maxElapsedTime)
+			return resp, err
+		}
+
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed (%s): %+v. Attempt %d of %d, retrying in %s", req.Method, req.URL, class, err, attempt, t.policy.maxAttempts, delay)
+		} else {
+			log.Printf("[DEBUG] %s %s failed (%s): HTTP %d. Attempt %d of %d, retrying in %s", req.Method, req.URL, class, resp.StatusCode, attempt, t.policy.maxAttempts, delay)
+			// Drain the response so that the connection can be reused
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, fmt.Errorf("%s %s cancelled while waiting to retry: %+v", req.Method, req.URL, req.Context().Err())
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		name             string
+		classes          []string
+		statusCodes      []int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "throttled request is retried",
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "client error is not retried",
+			statusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			expectedStatus:   http.StatusBadRequest,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "client error is retried when configured",
+			classes:          []string{retryClassClientError},
+			statusCodes:      []int{http.StatusNotFound, http.StatusOK},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "retries are limited to the maximum attempts",
+			statusCodes:      []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if body, _ := ioutil.ReadAll(r.Body); string(body) != "payload" {
+				t.Errorf("%s: expected the body to be sent with each attempt, got %q", tc.name, body)
+			}
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(tc.statusCodes[attempts])
+			attempts++
+		}))
+
+		client := &http.Client{
+			Transport: &retryTransport{
+				base:   http.DefaultTransport,
+				policy: newRetryPolicy(3, tc.classes, 0),
+			},
+		}
+
+		resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: error sending request: %+v", tc.name, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != tc.expectedStatus {
+			t.Fatalf("%s: expected status %d, got %d", tc.name, tc.expectedStatus, resp.StatusCode)
+		}
+		if attempts != tc.expectedAttempts {
+			t.Fatalf("%s: expected %d attempts, got %d", tc.name, tc.expectedAttempts, attempts)
+		}
+	}
+}
+
+func TestRetryTransport_MaxElapsedTime(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+		attempts++
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			base:   http.DefaultTransport,
+			policy: newRetryPolicy(5, nil, time.Minute),
+		},
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Fatalf("Expected the request not to be retried past the maximum elapsed time, got %d attempts", attempts)
+	}
+}
+
+func TestClassify_Connection(t *testing.T) {
+	cases := []struct {
+		method   string
+		err      error
+		expected string
+	}{
+		{http.MethodGet, fmt.Errorf("read: %w", syscall.ECONNRESET), retryClassConnection},
+		{http.MethodDelete, fmt.Errorf("read: %w", io.EOF), retryClassConnection},
+		{http.MethodPost, fmt.Errorf("dial: %w", syscall.ECONNREFUSED), retryClassConnection},
+		{http.MethodPost, fmt.Errorf("read: %w", syscall.ECONNRESET), ""},
+		{http.MethodPost, fmt.Errorf("read: %w", io.ErrUnexpectedEOF), ""},
+	}
+
+	for _, tc := range cases {
+		if class := classify(tc.method, nil, tc.err); class != tc.expected {
+			t.Fatalf("expected %s %q to be classified as %q, got %q", tc.method, tc.err, tc.expected, class)
+		}
+	}
+}
+
+func TestRetryPolicy_RetryDelay(t *testing.T) {
+	cases := []struct {
+		name           string
+		retryAfter     string
+		maxElapsedTime time.Duration
+		expected       time.Duration
+	}{
+		{"requested delay is used", "30", 0, 30 * time.Second},
+		{"requested delay is limited without a maximum elapsed time", "86400", 0, retryMaxBackoff},
+		{"requested delay is left to the maximum elapsed time", "86400", time.Hour, 86400 * time.Second},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Retry-After", tc.retryAfter)
+
+		policy := newRetryPolicy(3, nil, tc.maxElapsedTime)
+		if delay := policy.retryDelay(resp, 1); delay != tc.expected {
+			t.Fatalf("%s: expected a delay of %s, got %s", tc.name, tc.expected, delay)
+		}
+	}
+}
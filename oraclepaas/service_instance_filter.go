@@ -0,0 +1,181 @@
+package oraclepaas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The filter arguments shared by the service instance list data sources, along with the
+// `names` attribute listing the names of the matching service instances
+func serviceInstanceFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.ValidateRegexp,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// The filter arguments of the database, java and mysql service instance list data sources. Application
+// containers don't have a shape or version, so they only support the shared filters.
+func serviceInstanceShapeFilterSchema() map[string]*schema.Schema {
+	s := serviceInstanceFilterSchema()
+	s["shape"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return s
+}
+
+type serviceInstanceFilter struct {
+	nameRegex *regexp.Regexp
+	status    string
+	shape     string
+	version   string
+	tags      map[string]string
+}
+
+func expandServiceInstanceFilter(d *schema.ResourceData) (*serviceInstanceFilter, error) {
+	filter := &serviceInstanceFilter{
+		tags: make(map[string]string),
+	}
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error compiling `name_regex`: %+v", err)
+		}
+		filter.nameRegex = nameRegex
+	}
+	if v, ok := d.GetOk("status"); ok {
+		filter.status = v.(string)
+	}
+	if v, ok := d.GetOk("shape"); ok {
+		filter.shape = v.(string)
+	}
+	if v, ok := d.GetOk("version"); ok {
+		filter.version = v.(string)
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			filter.tags[key] = value.(string)
+		}
+	}
+
+	return filter, nil
+}
+
+// matchesSummary checks the filters that can be applied to the service instance list, so that
+// only the matching service instances need to be retrieved
+func (f *serviceInstanceFilter) matchesSummary(summary psmServiceInstanceSummary) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(summary.Name) {
+		return false
+	}
+	for key, value := range f.tags {
+		if v, ok := summary.Tags[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesDetails checks the filters that need the full details of the service instance
+func (f *serviceInstanceFilter) matchesDetails(status, shape, version string) bool {
+	if f.status != "" && !strings.EqualFold(f.status, status) {
+		return false
+	}
+	if f.shape != "" && !strings.EqualFold(f.shape, shape) {
+		return false
+	}
+	if f.version != "" && f.version != version {
+		return false
+	}
+	return true
+}
+
+// Sets the `names` attribute and an ID derived from the names of the matching service instances
+func setServiceInstanceNames(d *schema.ResourceData, names []string) error {
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting names: %+v", err)
+	}
+	return nil
+}
+
+// serviceInstanceReadFunc retrieves and flattens a single service instance for the list data sources,
+// returning nil if the service instance no longer exists. The flattened `status`, `shape` and `version`
+// are used to filter the service instances.
+type serviceInstanceReadFunc func(name string) (map[string]interface{}, error)
+
+func readServiceInstanceList(d *schema.ResourceData, meta interface{}, serviceType, key string, read serviceInstanceReadFunc) error {
+	client, err := getPSMClient(meta, serviceType)
+	if err != nil {
+		return err
+	}
+
+	filter, err := expandServiceInstanceFilter(d)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := client.listServiceInstances()
+	if err != nil {
+		return fmt.Errorf("Error listing service instances: %+v", err)
+	}
+
+	names := make([]string, 0, len(summaries))
+	result := make([]interface{}, 0, len(summaries))
+	for _, summary := range summaries {
+		if !filter.matchesSummary(summary) {
+			continue
+		}
+
+		details, err := read(summary.Name)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			continue
+		}
+
+		status, _ := details["status"].(string)
+		shape, _ := details["shape"].(string)
+		version, _ := details["version"].(string)
+		if !filter.matchesDetails(status, shape, version) {
+			continue
+		}
+
+		details["tags"] = summary.Tags
+		names = append(names, summary.Name)
+		result = append(result, details)
+	}
+
+	if err := d.Set(key, result); err != nil {
+		return fmt.Errorf("Error setting %s: %+v", key, err)
+	}
+	return setServiceInstanceNames(d, names)
+}
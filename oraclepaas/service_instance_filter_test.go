@@ -0,0 +1,89 @@
+package oraclepaas
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestServiceInstanceFilter_matchesSummary(t *testing.T) {
+	filter := &serviceInstanceFilter{
+		nameRegex: regexp.MustCompile("^prod-"),
+		tags:      map[string]string{"team": "platform"},
+	}
+
+	cases := []struct {
+		summary  psmServiceInstanceSummary
+		expected bool
+	}{
+		{psmServiceInstanceSummary{Name: "prod-db", Tags: map[string]string{"team": "platform", "env": "prod"}}, true},
+		{psmServiceInstanceSummary{Name: "dev-db", Tags: map[string]string{"team": "platform"}}, false},
+		{psmServiceInstanceSummary{Name: "prod-db", Tags: map[string]string{"team": "apps"}}, false},
+		{psmServiceInstanceSummary{Name: "prod-db"}, false},
+	}
+
+	for _, tc := range cases {
+		if actual := filter.matchesSummary(tc.summary); actual != tc.expected {
+			t.Fatalf("Expected %t for %+v, got %t", tc.expected, tc.summary, actual)
+		}
+	}
+}
+
+func TestServiceInstanceFilter_matchesDetails(t *testing.T) {
+	filter := &serviceInstanceFilter{
+		status:  "running",
+		shape:   "oc3",
+		version: "12.2.0.1",
+	}
+
+	if !filter.matchesDetails("RUNNING", "OC3", "12.2.0.1") {
+		t.Fatalf("Expected status and shape to be matched case insensitively")
+	}
+	if filter.matchesDetails("STOPPED", "oc3", "12.2.0.1") {
+		t.Fatalf("Expected a different status not to match")
+	}
+	if filter.matchesDetails("RUNNING", "oc3", "18.0.0.0") {
+		t.Fatalf("Expected a different version not to match")
+	}
+	if !(&serviceInstanceFilter{}).matchesDetails("STOPPED", "oc4", "18.0.0.0") {
+		t.Fatalf("Expected an empty filter to match")
+	}
+}
+
+func TestExpandPSMTags(t *testing.T) {
+	expected := map[string]string{"team": "platform"}
+
+	cases := []interface{}{
+		map[string]interface{}{
+			"items": []interface{}{map[string]interface{}{"key": "team", "value": "platform"}},
+		},
+		[]interface{}{map[string]interface{}{"key": "team", "value": "platform"}},
+		map[string]interface{}{"team": "platform"},
+	}
+
+	for _, tc := range cases {
+		if actual := expandPSMTags(tc); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected %+v for %+v, got %+v", expected, tc, actual)
+		}
+	}
+
+	if actual := expandPSMTags(nil); len(actual) != 0 {
+		t.Fatalf("Expected no tags, got %+v", actual)
+	}
+}
+
+func TestServiceInstanceFilterSchema_applicationContainers(t *testing.T) {
+	s := dataSourceOraclePAASApplicationContainers().Schema
+	for _, key := range []string{"shape", "version"} {
+		if _, ok := s[key]; ok {
+			t.Fatalf("Expected application containers not to support the %q filter", key)
+		}
+	}
+
+	s = dataSourceOraclePAASDatabaseServiceInstances().Schema
+	for _, key := range []string{"shape", "version"} {
+		if _, ok := s[key]; !ok {
+			t.Fatalf("Expected database service instances to support the %q filter", key)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package oraclepaas
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 func validateAccessRuleName(v interface{}, k string) (ws []string, errors []error) {
@@ -43,3 +44,13 @@ func validateMySQLServiceName(v interface{}, k string) (ws []string, errors []er
 	}
 	return
 }
+
+// Validates that the value is a duration, e.g. 30s or 10m
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. 30s or 10m. Got: %s", k, value))
+	}
+	return
+}